@@ -0,0 +1,214 @@
+// Package color renders text with ANSI SGR escape sequences
+package color
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"go_project/src/term"
+)
+
+// Color is a terminal color usable as a foreground or background
+type Color int
+
+// Named colors of the standard 16-color palette
+const (
+	Default Color = iota
+	Black
+	Red
+	Green
+	Yellow
+	Blue
+	Magenta
+	Cyan
+	White
+	BrightBlack
+	BrightRed
+	BrightGreen
+	BrightYellow
+	BrightBlue
+	BrightMagenta
+	BrightCyan
+	BrightWhite
+)
+
+// Reset is the SGR sequence that clears all attributes
+const Reset = "\x1b[0m"
+
+// Mode controls whether Colorize emits escape sequences
+type Mode int32
+
+// Color modes
+const (
+	// Auto colors output only when stdout is a terminal with color
+	// capability, which dumb terminals lack, and NO_COLOR is unset
+	Auto Mode = iota
+	// Always colors output regardless of the environment, even on a dumb
+	// terminal
+	Always
+	// Never leaves output uncolored
+	Never
+)
+
+var mode atomic.Int32
+
+// SetMode changes how Colorize decides whether to emit escape sequences
+func SetMode(m Mode) {
+	mode.Store(int32(m))
+}
+
+// CurrentMode returns the mode last set with SetMode
+func CurrentMode() Mode {
+	return Mode(mode.Load())
+}
+
+// Enabled reports whether Colorize currently emits escape sequences
+func Enabled() bool {
+	switch Mode(mode.Load()) {
+	case Always:
+		return true
+	case Never:
+		return false
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	return term.DetectCapabilities(os.Stdout).Color
+}
+
+// Colorize wraps text in the SGR sequence for fg and bg followed by a reset.
+// Default leaves that half of the pair untouched; text is returned unchanged
+// when color is disabled or both colors are Default.
+func Colorize(text string, fg, bg Color) string {
+	return Style{Fg: fg, Bg: bg}.Apply(text)
+}
+
+// param returns the SGR parameters selecting c as a foreground or background
+// color, downsampled to what depth supports. It reports false for Default
+// and invalid colors.
+func (c Color) param(background bool, depth ColorDepth) (string, bool) {
+	extended := 38
+	if background {
+		extended = 48
+	}
+	switch {
+	case c&rgbFlag != 0:
+		r, g, b := c.rgb()
+		switch depth {
+		case DepthTrueColor:
+			return fmt.Sprintf("%d;2;%d;%d;%d", extended, r, g, b), true
+		case Depth256:
+			return fmt.Sprintf("%d;5;%d", extended, Nearest256(r, g, b)), true
+		}
+		return nearest16(r, g, b).param(background, depth)
+	case c&indexedFlag != 0:
+		n := uint8(c)
+		if n < 16 {
+			return Color(n+1).param(background, depth)
+		}
+		if depth >= Depth256 {
+			return fmt.Sprintf("%d;5;%d", extended, n), true
+		}
+		return nearest16(c.rgb()).param(background, depth)
+	case c >= Black && c <= BrightWhite:
+		normal, bright := 30, 90
+		if background {
+			normal, bright = 40, 100
+		}
+		if c >= BrightBlack {
+			return strconv.Itoa(bright + int(c-BrightBlack)), true
+		}
+		return strconv.Itoa(normal + int(c-Black)), true
+	}
+	return "", false
+}
+
+// names maps each named color to the identifier used by Parse and String
+var names = map[Color]string{
+	Default:       "default",
+	Black:         "black",
+	Red:           "red",
+	Green:         "green",
+	Yellow:        "yellow",
+	Blue:          "blue",
+	Magenta:       "magenta",
+	Cyan:          "cyan",
+	White:         "white",
+	BrightBlack:   "bright-black",
+	BrightRed:     "bright-red",
+	BrightGreen:   "bright-green",
+	BrightYellow:  "bright-yellow",
+	BrightBlue:    "bright-blue",
+	BrightMagenta: "bright-magenta",
+	BrightCyan:    "bright-cyan",
+	BrightWhite:   "bright-white",
+}
+
+// Parse looks up a color by name, ignoring case, e.g. "red" or "bright-blue".
+// It also accepts "#rrggbb" for an RGB color and "0" to "255" for an entry
+// of the 256-color palette.
+func Parse(name string) (Color, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for c, n := range names {
+		if n == name {
+			return c, nil
+		}
+	}
+	if hex, ok := strings.CutPrefix(name, "#"); ok && len(hex) == 6 {
+		if v, err := strconv.ParseUint(hex, 16, 24); err == nil {
+			return RGB(uint8(v>>16), uint8(v>>8), uint8(v)), nil
+		}
+	}
+	if n, err := strconv.ParseUint(name, 10, 8); err == nil {
+		return Indexed(uint8(n)), nil
+	}
+	return Default, fmt.Errorf("unknown color %q", name)
+}
+
+// String returns the name of c as accepted by Parse
+func (c Color) String() string {
+	if n, ok := names[c]; ok {
+		return n
+	}
+	switch {
+	case c&rgbFlag != 0:
+		r, g, b := c.rgb()
+		return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+	case c&indexedFlag != 0:
+		return strconv.Itoa(int(uint8(c)))
+	}
+	return fmt.Sprintf("Color(%d)", int(c))
+}
+
+// valid reports whether c is a named, palette or RGB color
+func (c Color) valid() bool {
+	switch {
+	case c&rgbFlag != 0:
+		return c&^(rgbFlag|0xffffff) == 0
+	case c&indexedFlag != 0:
+		return c&^(indexedFlag|0xff) == 0
+	}
+	_, named := names[c]
+	return named
+}
+
+// MarshalText encodes c in the form accepted by Parse
+func (c Color) MarshalText() ([]byte, error) {
+	if !c.valid() {
+		return nil, fmt.Errorf("unknown color %d", int(c))
+	}
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText decodes a color name, rejecting names Parse does not know
+func (c *Color) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
@@ -0,0 +1,29 @@
+package color
+
+import "math"
+
+// Luminance returns the relative luminance of c between 0 (black) and 1
+// (white), as defined by WCAG 2. Palette colors use their xterm defaults.
+func Luminance(c Color) float64 {
+	r, g, b := c.rgb()
+	return 0.2126*linear(r) + 0.7152*linear(g) + 0.0722*linear(b)
+}
+
+// ContrastColor returns Black or BrightWhite, whichever is more readable as
+// text on a background of bg
+func ContrastColor(bg Color) Color {
+	// Above this luminance black text has the higher contrast ratio
+	if Luminance(bg) > 0.179 {
+		return Black
+	}
+	return BrightWhite
+}
+
+// linear converts an sRGB channel to linear light
+func linear(v uint8) float64 {
+	c := float64(v) / 255
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
@@ -0,0 +1,134 @@
+package color
+
+import (
+	"os"
+	"strings"
+)
+
+// Flags marking colors outside the named 16-color palette. The low bits of
+// an RGB color hold its channels and those of an indexed color its palette
+// entry.
+const (
+	rgbFlag     Color = 1 << 24
+	indexedFlag Color = 1 << 25
+)
+
+// RGB returns a 24-bit color. Terminals with fewer colors receive the
+// nearest entry of their palette instead.
+func RGB(r, g, b uint8) Color {
+	return rgbFlag | Color(r)<<16 | Color(g)<<8 | Color(b)
+}
+
+// Indexed returns entry n of the 256-color palette. Entries 0-15 are the
+// named colors Black through BrightWhite.
+func Indexed(n uint8) Color {
+	return indexedFlag | Color(n)
+}
+
+// ColorDepth is the number of colors a terminal can display
+type ColorDepth int
+
+// Color depths in increasing order
+const (
+	Depth16 ColorDepth = iota
+	Depth256
+	DepthTrueColor
+)
+
+// DetectColorDepth infers the color depth of the terminal from $COLORTERM
+// and $TERM, assuming the basic 16 colors when neither says otherwise
+func DetectColorDepth() ColorDepth {
+	switch strings.ToLower(os.Getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return DepthTrueColor
+	}
+	termName := os.Getenv("TERM")
+	switch {
+	case strings.HasSuffix(termName, "-direct"):
+		return DepthTrueColor
+	case strings.Contains(termName, "256color"):
+		return Depth256
+	}
+	return Depth16
+}
+
+// palette16 holds the xterm default RGB values of Black through BrightWhite
+var palette16 = [16][3]uint8{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// cubeLevels are the channel intensities of the 6x6x6 color cube occupying
+// palette entries 16-231
+var cubeLevels = [6]uint8{0, 95, 135, 175, 215, 255}
+
+// rgb returns the channels of c, using the xterm defaults for palette colors.
+// Default and invalid colors are black.
+func (c Color) rgb() (r, g, b uint8) {
+	switch {
+	case c&rgbFlag != 0:
+		return uint8(c >> 16), uint8(c >> 8), uint8(c)
+	case c&indexedFlag != 0:
+		n := int(uint8(c))
+		switch {
+		case n < 16:
+			p := palette16[n]
+			return p[0], p[1], p[2]
+		case n < 232:
+			n -= 16
+			return cubeLevels[n/36], cubeLevels[n/6%6], cubeLevels[n%6]
+		}
+		v := uint8(8 + 10*(n-232))
+		return v, v, v
+	case c >= Black && c <= BrightWhite:
+		p := palette16[c-Black]
+		return p[0], p[1], p[2]
+	}
+	return 0, 0, 0
+}
+
+// Nearest256 returns the entry of the 256-color palette closest to the given
+// RGB value, choosing between the color cube and the grayscale ramp
+func Nearest256(r, g, b uint8) uint8 {
+	cube := func(v uint8) int {
+		switch {
+		case v < 48:
+			return 0
+		case v < 115:
+			return 1
+		}
+		return (int(v) - 35) / 40
+	}
+	ri, gi, bi := cube(r), cube(g), cube(b)
+	cubeDist := distance(r, g, b, cubeLevels[ri], cubeLevels[gi], cubeLevels[bi])
+
+	avg := (int(r) + int(g) + int(b)) / 3
+	grayIndex := 23
+	if avg < 238 {
+		grayIndex = max(avg-3, 0) / 10
+	}
+	gray := uint8(8 + 10*grayIndex)
+	if distance(r, g, b, gray, gray, gray) < cubeDist {
+		return uint8(232 + grayIndex)
+	}
+	return uint8(16 + 36*ri + 6*gi + bi)
+}
+
+// nearest16 returns the named color closest to the given RGB value
+func nearest16(r, g, b uint8) Color {
+	best, bestDist := Black, -1
+	for i, p := range palette16 {
+		if d := distance(r, g, b, p[0], p[1], p[2]); bestDist < 0 || d < bestDist {
+			best, bestDist = Black+Color(i), d
+		}
+	}
+	return best
+}
+
+// distance returns the squared euclidean distance between two RGB values
+func distance(r1, g1, b1, r2, g2, b2 uint8) int {
+	dr, dg, db := int(r1)-int(r2), int(g1)-int(g2), int(b1)-int(b2)
+	return dr*dr + dg*dg + db*db
+}
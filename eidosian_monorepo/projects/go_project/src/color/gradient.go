@@ -0,0 +1,43 @@
+package color
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Gradient colors each rune of text with a foreground interpolated from
+// from to to. Whitespace is left unstyled but still advances the gradient so
+// colors line up across words. Terminals without true color receive text in
+// the single color from.
+func Gradient(text string, from, to Color) string {
+	if !Enabled() || text == "" {
+		return text
+	}
+	if DetectColorDepth() != DepthTrueColor {
+		return Colorize(text, from, Default)
+	}
+
+	runes := []rune(text)
+	r1, g1, b1 := from.rgb()
+	r2, g2, b2 := to.rgb()
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsSpace(r) {
+			b.WriteRune(r)
+			continue
+		}
+		t := 0.0
+		if len(runes) > 1 {
+			t = float64(i) / float64(len(runes)-1)
+		}
+		fmt.Fprintf(&b, "\x1b[38;2;%d;%d;%dm%c", lerp(r1, r2, t), lerp(g1, g2, t), lerp(b1, b2, t), r)
+	}
+	b.WriteString(Reset)
+	return b.String()
+}
+
+// lerp interpolates between two channel values, rounding to the nearest
+func lerp(a, b uint8, t float64) uint8 {
+	return uint8(float64(a) + (float64(b)-float64(a))*t + 0.5)
+}
@@ -0,0 +1,84 @@
+package color
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Attribute is a set of text attributes such as bold or underline
+type Attribute uint8
+
+// Text attributes, combinable with |
+const (
+	Bold Attribute = 1 << iota
+	Dim
+	Italic
+	Underline
+	Blink
+	Strikethrough
+)
+
+// attributeParams lists each attribute with its SGR parameter, in the order
+// Apply emits them
+var attributeParams = []struct {
+	attr  Attribute
+	param int
+}{
+	{Bold, 1}, {Dim, 2}, {Italic, 3}, {Underline, 4}, {Blink, 5}, {Strikethrough, 9},
+}
+
+// extendedAttributes are not understood by basic terminals such as the
+// Linux console, so Apply leaves them out there
+const extendedAttributes = Italic | Strikethrough
+
+// basicTerminal reports whether $TERM names a terminal that draws
+// extendedAttributes wrongly or not at all: the Linux and BSD consoles,
+// plain ANSI and the VT family. Color depth is no guide, since 16-color
+// emulators such as plain xterm render italics fine.
+func basicTerminal() bool {
+	t := os.Getenv("TERM")
+	switch t {
+	case "linux", "cons25", "ansi":
+		return true
+	}
+	return strings.HasPrefix(t, "vt")
+}
+
+// Style combines text attributes with foreground and background colors.
+// The zero value applies no styling.
+type Style struct {
+	Attrs  Attribute
+	Fg, Bg Color
+}
+
+// Apply wraps text in a single SGR sequence selecting every attribute and
+// color of s, followed by one reset. Italic and strikethrough are left out
+// on basic terminals, as decided by $TERM. Text is returned unchanged when
+// color is disabled or s selects nothing the terminal supports.
+func (s Style) Apply(text string) string {
+	if !Enabled() {
+		return text
+	}
+	depth := DetectColorDepth()
+	attrs := s.Attrs
+	if basicTerminal() {
+		attrs &^= extendedAttributes
+	}
+	var params []string
+	for _, a := range attributeParams {
+		if attrs&a.attr != 0 {
+			params = append(params, strconv.Itoa(a.param))
+		}
+	}
+	if p, ok := s.Fg.param(false, depth); ok {
+		params = append(params, p)
+	}
+	if p, ok := s.Bg.param(true, depth); ok {
+		params = append(params, p)
+	}
+	if len(params) == 0 {
+		return text
+	}
+	return "\x1b[" + strings.Join(params, ";") + "m" + text + Reset
+}
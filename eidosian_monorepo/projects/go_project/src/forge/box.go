@@ -0,0 +1,142 @@
+package forge
+
+import (
+	"strings"
+
+	"go_project/src/color"
+	"go_project/src/term"
+	"go_project/src/text"
+	"go_project/src/theme"
+)
+
+// BoxStyle selects the glyphs used to draw a box frame
+type BoxStyle int
+
+// Available box styles
+const (
+	StyleSingle BoxStyle = iota
+	StyleDouble
+	StyleRounded
+)
+
+// boxGlyphs holds the frame characters for one BoxStyle
+type boxGlyphs struct {
+	topLeft, topRight, bottomLeft, bottomRight string
+	horizontal, vertical                       string
+}
+
+var boxStyles = map[BoxStyle]boxGlyphs{
+	StyleSingle:  {"┌", "┐", "└", "┘", "─", "│"},
+	StyleDouble:  {"╔", "╗", "╚", "╝", "═", "║"},
+	StyleRounded: {"╭", "╮", "╰", "╯", "─", "│"},
+}
+
+// TitleAlignment positions a title or footer within its border
+type TitleAlignment int
+
+// Title alignments
+const (
+	TitleLeft TitleAlignment = iota
+	TitleCenter
+	TitleRight
+)
+
+// BoxOptions configures how RenderBoxWith draws a result
+type BoxOptions struct {
+	Style BoxStyle
+	// MaxWidth caps the total width of the box; zero means the terminal width
+	MaxWidth int
+	// Theme colors the frame and status; nil uses the default theme
+	Theme *theme.Theme
+	// Title and Footer are embedded in the top and bottom borders, truncated
+	// with an ellipsis when the border is too short
+	Title, Footer string
+	TitleAlign    TitleAlignment
+	FooterAlign   TitleAlignment
+}
+
+// RenderBox draws the status and message of r inside a single-line frame
+func RenderBox(r Result) string {
+	return RenderBoxWith(r, BoxOptions{})
+}
+
+// RenderBoxWith draws r inside a frame sized to its longest line, wrapping
+// lines that would make the box wider than opts.MaxWidth
+func RenderBoxWith(r Result, opts BoxOptions) string {
+	g, ok := boxStyles[opts.Style]
+	if !ok {
+		g = boxStyles[StyleSingle]
+	}
+	maxWidth := opts.MaxWidth
+	if maxWidth <= 0 {
+		maxWidth = term.Width()
+	}
+	// Two border columns plus one column of padding on each side
+	limit := maxWidth - 4
+	if limit < 1 {
+		limit = 1
+	}
+
+	th := opts.Theme.OrDefault()
+	border := func(s string) string {
+		return color.Colorize(s, th.BorderColor, color.Default)
+	}
+	label := func(s string) string {
+		return color.Colorize(s, th.AccentColor, color.Default)
+	}
+
+	lines := text.WrapText(r.Status, limit)
+	statusLines := len(lines)
+	if r.Message != "" {
+		lines = append(lines, text.WrapText(r.Message, limit)...)
+	}
+	inner := 0
+	for _, line := range lines {
+		inner = max(inner, text.DisplayWidth(line))
+	}
+	// Widen the box, within the limit, so that labels fit without truncation
+	for _, s := range []string{opts.Title, opts.Footer} {
+		if s != "" {
+			inner = max(inner, min(text.DisplayWidth(s)+labelMargin-2, limit))
+		}
+	}
+
+	top := labelBorder(g, inner+2, opts.Title, opts.TitleAlign, border, label)
+	bottom := labelBorder(g, inner+2, opts.Footer, opts.FooterAlign, border, label)
+	var b strings.Builder
+	b.WriteString(border(g.topLeft) + top + border(g.topRight) + "\n")
+	for i, line := range lines {
+		if i < statusLines {
+			line = color.Colorize(line, th.StatusColor(r.Status), color.Default)
+		}
+		b.WriteString(border(g.vertical) + " " + text.PadRight(line, inner) + " " + border(g.vertical) + "\n")
+	}
+	b.WriteString(border(g.bottomLeft) + bottom + border(g.bottomRight))
+	return b.String()
+}
+
+// labelMargin is the border a label keeps around it: one horizontal glyph
+// and one space on each side
+const labelMargin = 4
+
+// labelBorder draws span columns of horizontal border with s embedded at
+// align, truncating s to fit. The border is plain when s is empty or there
+// is no room for it.
+func labelBorder(g boxGlyphs, span int, s string, align TitleAlignment, border, label func(string) string) string {
+	room := span - labelMargin
+	if s == "" || room < 1 {
+		return border(strings.Repeat(g.horizontal, span))
+	}
+	s = text.Truncate(s, room)
+	gap := room - text.DisplayWidth(s)
+	left := 0
+	switch align {
+	case TitleCenter:
+		left = gap / 2
+	case TitleRight:
+		left = gap
+	}
+	before := strings.Repeat(g.horizontal, 1+left) + " "
+	after := " " + strings.Repeat(g.horizontal, 1+gap-left)
+	return border(before) + label(s) + border(after)
+}
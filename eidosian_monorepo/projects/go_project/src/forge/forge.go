@@ -0,0 +1,131 @@
+// Package forge implements the core functionality of the project. It has no
+// process-level side effects: callers decide where output goes and how the
+// process exits.
+package forge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Exit codes reported by the process
+const (
+	ExitOK    = 0
+	ExitError = 1
+	ExitUsage = 2
+	// ExitInterrupted follows the shell convention of 128 plus SIGINT
+	ExitInterrupted = 130
+)
+
+// Statuses a result may carry
+const (
+	StatusSuccess = "success"
+	StatusError   = "error"
+	StatusWarning = "warning"
+)
+
+// Statuses lists every valid result status
+var Statuses = []string{StatusSuccess, StatusError, StatusWarning}
+
+// Result represents the output of the run function
+type Result struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	// Fields carries optional metadata; it is omitted when empty so that
+	// consumers reading only status and message are unaffected
+	Fields map[string]any `json:"fields,omitempty"`
+}
+
+// WithField returns a copy of r with key set to val in its fields. The
+// receiver's map is never modified.
+func (r Result) WithField(key string, val any) Result {
+	fields := make(map[string]any, len(r.Fields)+1)
+	for k, v := range r.Fields {
+		fields[k] = v
+	}
+	fields[key] = val
+	r.Fields = fields
+	return r
+}
+
+// Validate reports whether r has a known status and a message
+func (r Result) Validate() error {
+	if !slices.Contains(Statuses, r.Status) {
+		return fmt.Errorf("invalid status %q", r.Status)
+	}
+	if r.Message == "" {
+		return errors.New("empty message")
+	}
+	return nil
+}
+
+// Options configures a run
+type Options struct {
+	// DryRun reports what would happen without performing any actions
+	DryRun bool
+}
+
+// Run executes the main functionality of the project with default options
+func Run() Result {
+	return RunWithOptions(Options{})
+}
+
+// RunWithOptions executes the main functionality of the project. If the
+// result it produces fails validation, an error result describing the
+// failure is returned instead.
+func RunWithOptions(opts Options) Result {
+	result := Result{
+		Status:  StatusSuccess,
+		Message: "Hello from Go project!",
+	}
+	if opts.DryRun {
+		result.Message = "dry-run: no actions performed"
+	}
+	result.Code = ExitCode(result.Status)
+	if err := result.Validate(); err != nil {
+		return Result{
+			Status:  StatusError,
+			Message: fmt.Sprintf("internal error: %v", err),
+			Code:    ExitError,
+		}
+	}
+	return result
+}
+
+// RunContext is like Run but gives up when ctx is canceled
+func RunContext(ctx context.Context) Result {
+	return RunContextWithOptions(ctx, Options{})
+}
+
+// RunContextWithOptions is like RunWithOptions but returns an error result
+// with the message "canceled" if ctx is canceled before the run completes.
+// A context that is already canceled returns without doing any work.
+func RunContextWithOptions(ctx context.Context, opts Options) Result {
+	if ctx.Err() != nil {
+		return canceledResult()
+	}
+	done := make(chan Result, 1)
+	go func() { done <- RunWithOptions(opts) }()
+	select {
+	case result := <-done:
+		return result
+	case <-ctx.Done():
+		return canceledResult()
+	}
+}
+
+// canceledResult reports a run abandoned because its context was canceled
+func canceledResult() Result {
+	return Result{Status: StatusError, Message: "canceled", Code: ExitError}
+}
+
+// ExitCode maps a result status to the process exit code it should produce
+func ExitCode(status string) int {
+	if status == StatusSuccess {
+		return ExitOK
+	}
+	return ExitError
+}
@@ -0,0 +1,233 @@
+package forge
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Output formats understood by Marshal
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// FormatHuman selects the readable single-line rendering used on terminals.
+// It is chosen by ResolveFormat but rendered by the caller, not Marshal.
+const FormatHuman = "human"
+
+// EnvFormat names the environment variable consulted by ResolveFormat
+const EnvFormat = "FORGE_FORMAT"
+
+// ErrUnknownFormat is returned for format names Marshal does not recognise
+var ErrUnknownFormat = errors.New("unknown format")
+
+// ResolveFormat picks the output format. An explicit flag value beats the
+// FORGE_FORMAT environment value, which beats the default of human output on
+// a terminal and JSON otherwise. A value that is set but unknown is an error
+// at every level, even when a higher-precedence value would win.
+func ResolveFormat(flag, env string, isTTY bool) (string, error) {
+	if err := checkFormat("--format", flag); err != nil {
+		return "", err
+	}
+	if err := checkFormat(EnvFormat, env); err != nil {
+		return "", err
+	}
+	switch {
+	case flag != "":
+		return flag, nil
+	case env != "":
+		return env, nil
+	case isTTY:
+		return FormatHuman, nil
+	}
+	return FormatJSON, nil
+}
+
+// checkFormat reports an error naming source if format is set but neither
+// built in nor registered
+func checkFormat(source, format string) error {
+	if format == "" || isBuiltinFormat(format) {
+		return nil
+	}
+	if _, ok := lookupRenderer(format); ok {
+		return nil
+	}
+	return fmt.Errorf("%s: %w %q (want %s, %s or %s)", source, ErrUnknownFormat, format, FormatHuman, FormatJSON, FormatYAML)
+}
+
+// Marshal encodes r in the named format, consulting renderers added with
+// RegisterRenderer after the built-in ones
+func Marshal(r Result, format string) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		return json.Marshal(r)
+	case FormatYAML:
+		return marshalYAML(r)
+	default:
+		if fn, ok := lookupRenderer(format); ok {
+			return fn(r)
+		}
+		return nil, fmt.Errorf("%w %q (want %s or %s)", ErrUnknownFormat, format, FormatJSON, FormatYAML)
+	}
+}
+
+// Unmarshal decodes data in the named format into r
+func Unmarshal(data []byte, format string, r *Result) error {
+	switch format {
+	case FormatJSON:
+		return json.Unmarshal(data, r)
+	case FormatYAML:
+		return unmarshalYAML(data, r)
+	default:
+		return fmt.Errorf("%w %q (want %s or %s)", ErrUnknownFormat, format, FormatJSON, FormatYAML)
+	}
+}
+
+// marshalYAML writes r as a flat YAML mapping. Strings are emitted as
+// double-quoted scalars, which share their escape syntax with JSON.
+func marshalYAML(r Result) ([]byte, error) {
+	status, err := yamlString(r.Status)
+	if err != nil {
+		return nil, err
+	}
+	message, err := yamlString(r.Message)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "status: %s\n", status)
+	fmt.Fprintf(&b, "message: %s\n", message)
+	fmt.Fprintf(&b, "code: %d\n", r.Code)
+	if len(r.Fields) > 0 {
+		b.WriteString("fields:\n")
+		keys := make([]string, 0, len(r.Fields))
+		for k := range r.Fields {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			key, err := yamlString(k)
+			if err != nil {
+				return nil, err
+			}
+			// JSON values are valid YAML flow scalars and collections
+			value, err := json.Marshal(r.Fields[k])
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", k, err)
+			}
+			fmt.Fprintf(&b, "  %s: %s\n", key, value)
+		}
+	}
+	return b.Bytes(), nil
+}
+
+// yamlString quotes s as a YAML double-quoted scalar
+func yamlString(s string) (string, error) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// unmarshalYAML reads the mapping produced by marshalYAML
+func unmarshalYAML(data []byte, r *Result) error {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	inFields := false
+	for n := 1; scanner.Scan(); n++ {
+		raw := scanner.Text()
+		line := strings.TrimSpace(raw)
+		if line == "" || line == "---" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if indented := raw[0] == ' ' || raw[0] == '\t'; inFields && indented {
+			key, value, err := yamlField(line)
+			if err != nil {
+				return fmt.Errorf("yaml line %d: %w", n, err)
+			}
+			if r.Fields == nil {
+				r.Fields = make(map[string]any)
+			}
+			r.Fields[key] = value
+			continue
+		}
+		inFields = false
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return fmt.Errorf("yaml line %d: expected key: value", n)
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "status":
+			s, err := yamlScalar(value)
+			if err != nil {
+				return fmt.Errorf("yaml line %d: %w", n, err)
+			}
+			r.Status = s
+		case "message":
+			s, err := yamlScalar(value)
+			if err != nil {
+				return fmt.Errorf("yaml line %d: %w", n, err)
+			}
+			r.Message = s
+		case "code":
+			code, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("yaml line %d: invalid code: %w", n, err)
+			}
+			r.Code = code
+		case "fields":
+			inFields = true
+		}
+	}
+	return scanner.Err()
+}
+
+// yamlField decodes one indented key: value line of the fields mapping.
+// Values that are not valid JSON are kept as plain strings.
+func yamlField(line string) (string, any, error) {
+	var key, rest string
+	if strings.HasPrefix(line, `"`) {
+		dec := json.NewDecoder(strings.NewReader(line))
+		if err := dec.Decode(&key); err != nil {
+			return "", nil, fmt.Errorf("invalid field key: %w", err)
+		}
+		rest = line[dec.InputOffset():]
+	} else {
+		i := strings.Index(line, ":")
+		if i < 0 {
+			return "", nil, errors.New("expected key: value")
+		}
+		key, rest = strings.TrimSpace(line[:i]), line[i:]
+	}
+	rest, ok := strings.CutPrefix(strings.TrimSpace(rest), ":")
+	if !ok {
+		return "", nil, fmt.Errorf("field %q: expected key: value", key)
+	}
+	rest = strings.TrimSpace(rest)
+	var value any
+	if err := json.Unmarshal([]byte(rest), &value); err != nil {
+		return key, rest, nil
+	}
+	return key, value, nil
+}
+
+// yamlScalar decodes a plain or double-quoted YAML scalar
+func yamlScalar(value string) (string, error) {
+	if strings.HasPrefix(value, `"`) {
+		var s string
+		if err := json.Unmarshal([]byte(value), &s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+	return value, nil
+}
@@ -0,0 +1,36 @@
+package forge
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// resultPrefix labels the JSON line printed by the forge command; it is
+// accepted on input so that forge output can be piped straight back in
+const resultPrefix = "Result:"
+
+// ReadResult decodes a JSON-encoded result from r. It returns io.EOF when r
+// holds nothing but whitespace. A result whose status is not success but
+// which carries no exit code is given the code ExitCode reports.
+func ReadResult(r io.Reader) (Result, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Result{}, fmt.Errorf("read result: %w", err)
+	}
+	data = bytes.TrimSpace(data)
+	data = bytes.TrimSpace(bytes.TrimPrefix(data, []byte(resultPrefix)))
+	if len(data) == 0 {
+		return Result{}, io.EOF
+	}
+
+	var result Result
+	if err := json.Unmarshal(data, &result); err != nil {
+		return Result{}, fmt.Errorf("invalid result: %w", err)
+	}
+	if result.Code == ExitOK {
+		result.Code = ExitCode(result.Status)
+	}
+	return result, nil
+}
@@ -0,0 +1,60 @@
+package forge
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Renderer encodes a result in a custom output format
+type Renderer func(Result) ([]byte, error)
+
+var (
+	renderersMu sync.RWMutex
+	renderers   = make(map[string]Renderer)
+)
+
+// RegisterRenderer makes fn available as the output format name, so that
+// packages can add formats from an init function. Names must be non-empty
+// and may not shadow a built-in or previously registered format.
+func RegisterRenderer(name string, fn func(Result) ([]byte, error)) error {
+	if name == "" {
+		return errors.New("register renderer: empty name")
+	}
+	if fn == nil {
+		return fmt.Errorf("register renderer %q: nil function", name)
+	}
+	renderersMu.Lock()
+	defer renderersMu.Unlock()
+	if isBuiltinFormat(name) || renderers[name] != nil {
+		return fmt.Errorf("register renderer %q: format already registered", name)
+	}
+	renderers[name] = fn
+	return nil
+}
+
+// UnregisterRenderer removes the format name added by RegisterRenderer, so
+// that tests can clean up after themselves. Built-in and unknown names are
+// left alone.
+func UnregisterRenderer(name string) {
+	renderersMu.Lock()
+	defer renderersMu.Unlock()
+	delete(renderers, name)
+}
+
+// lookupRenderer returns the renderer registered under name, if any
+func lookupRenderer(name string) (Renderer, bool) {
+	renderersMu.RLock()
+	defer renderersMu.RUnlock()
+	fn, ok := renderers[name]
+	return fn, ok
+}
+
+// isBuiltinFormat reports whether name is handled without the registry
+func isBuiltinFormat(name string) bool {
+	switch name {
+	case FormatHuman, FormatJSON, FormatYAML:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package forge
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"go_project/src/color"
+	"go_project/src/term"
+	"go_project/src/text"
+	"go_project/src/theme"
+)
+
+// fieldIndent sets the result fields apart from the message line
+const fieldIndent = "  "
+
+// RenderOptions configures Render
+type RenderOptions struct {
+	// Format is FormatHuman, a format understood by Marshal, or one added
+	// with RegisterRenderer
+	Format string
+	// Theme colors human output; nil uses the default theme
+	Theme *theme.Theme
+	// Icons prefixes human output with the theme's status icon
+	Icons bool
+	// ASCII draws the icons as plain-text markers; they are also drawn that
+	// way when w is not a terminal that can render symbols
+	ASCII bool
+	// Capabilities describes the terminal behind w; nil detects them from w
+	Capabilities *term.Capabilities
+	// NoColor strips escape sequences from the rendered output
+	NoColor bool
+	// Timestamps prefixes each line of human output with the time;
+	// structured formats are left untouched
+	Timestamps bool
+	// Now is the clock used for timestamps; nil uses time.Now
+	Now func() time.Time
+}
+
+// Render writes r to w in opts.Format. JSON is written as a single line
+// labelled the way ReadResult accepts, so output can be piped back into
+// forge.
+func Render(w io.Writer, r Result, opts RenderOptions) error {
+	var out string
+	switch opts.Format {
+	case FormatHuman:
+		th := opts.Theme.OrDefault()
+		out = RenderHuman(r, th) + "\n"
+		if opts.Icons {
+			caps := term.DetectCapabilities(w)
+			if opts.Capabilities != nil {
+				caps = *opts.Capabilities
+			}
+			icons := theme.IconsFor(th, caps)
+			icons.ASCII = icons.ASCII || opts.ASCII
+			out = icons.StatusIcon(r.Status) + " " + out
+		}
+	default:
+		data, err := Marshal(r, opts.Format)
+		if err != nil {
+			return fmt.Errorf("marshal result: %w", err)
+		}
+		out = string(data)
+		if opts.Format == FormatJSON {
+			out = resultPrefix + " " + out + "\n"
+		}
+	}
+	if opts.NoColor {
+		out = text.StripANSI(out)
+	}
+
+	if opts.Timestamps && opts.Format == FormatHuman {
+		tw := text.NewTimestampWriter(w, opts.Now)
+		if _, err := io.WriteString(tw, out); err != nil {
+			return err
+		}
+		return tw.Flush()
+	}
+	_, err := io.WriteString(w, out)
+	return err
+}
+
+// RenderHuman formats r for interactive terminals as a status line followed
+// by an indented block of its fields, if any
+func RenderHuman(r Result, th *theme.Theme) string {
+	status := color.Colorize(r.Status, th.StatusColor(r.Status), color.Default)
+	line := fmt.Sprintf("%s: %s", status, text.RenderMarkdown(r.Message))
+	if len(r.Fields) == 0 {
+		return line
+	}
+
+	keys := make([]string, 0, len(r.Fields))
+	for k := range r.Fields {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	list := text.DefinitionList{Width: term.Width() - len(fieldIndent)}
+	for _, k := range keys {
+		list.Add(k, fmt.Sprint(r.Fields[k]))
+	}
+	block := fieldIndent + strings.ReplaceAll(list.Render(), "\n", "\n"+fieldIndent)
+	return line + "\n" + block
+}
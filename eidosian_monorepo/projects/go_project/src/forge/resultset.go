@@ -0,0 +1,56 @@
+package forge
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ResultSet collects the results of several operations. It marshals to JSON
+// as an array of results.
+type ResultSet struct {
+	Results []Result
+}
+
+// Add appends r to the set
+func (s *ResultSet) Add(r Result) {
+	s.Results = append(s.Results, r)
+}
+
+// Len returns the number of results in the set
+func (s *ResultSet) Len() int {
+	return len(s.Results)
+}
+
+// Summary rolls the set up into a single result. Its status is error if any
+// result errored and success otherwise, and its message counts each outcome.
+func (s *ResultSet) Summary() Result {
+	counts := make(map[string]int)
+	for _, r := range s.Results {
+		counts[r.Status]++
+	}
+	status := StatusSuccess
+	if counts[StatusError] > 0 {
+		status = StatusError
+	}
+	return Result{
+		Status: status,
+		Message: fmt.Sprintf("%d results: %d success, %d warning, %d error",
+			len(s.Results), counts[StatusSuccess], counts[StatusWarning], counts[StatusError]),
+		Code: ExitCode(status),
+	}
+}
+
+// MarshalJSON encodes the set as a JSON array, empty rather than null when
+// the set has no results
+func (s ResultSet) MarshalJSON() ([]byte, error) {
+	results := s.Results
+	if results == nil {
+		results = []Result{}
+	}
+	return json.Marshal(results)
+}
+
+// UnmarshalJSON decodes a JSON array of results
+func (s *ResultSet) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &s.Results)
+}
@@ -0,0 +1,67 @@
+package forge
+
+import (
+	"fmt"
+	"time"
+)
+
+// Backoff defaults used when RetryOptions leaves a delay unset
+const (
+	DefaultBaseDelay = 100 * time.Millisecond
+	DefaultMaxDelay  = 5 * time.Second
+)
+
+// RetryOptions configures RunWithRetry
+type RetryOptions struct {
+	Options
+	// MaxAttempts is the total number of runs; values below one mean one
+	MaxAttempts int
+	// BaseDelay is the wait before the second attempt; each later wait
+	// doubles, up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// Run performs one attempt; nil uses RunWithOptions
+	Run func(Options) Result
+	// Sleep waits between attempts; nil uses time.Sleep
+	Sleep func(time.Duration)
+}
+
+// RunWithRetry runs until a result is not an error or MaxAttempts runs have
+// been made, backing off exponentially between attempts. When every attempt
+// fails the last result is returned with the attempt count appended to its
+// message.
+func RunWithRetry(opts RetryOptions) Result {
+	run := opts.Run
+	if run == nil {
+		run = RunWithOptions
+	}
+	sleep := opts.Sleep
+	if sleep == nil {
+		sleep = time.Sleep
+	}
+	attempts := max(opts.MaxAttempts, 1)
+	maxDelay := opts.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = DefaultMaxDelay
+	}
+	delay := opts.BaseDelay
+	if delay <= 0 {
+		delay = DefaultBaseDelay
+	}
+	delay = min(delay, maxDelay)
+
+	var result Result
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			sleep(delay)
+			// Capping before the next doubling keeps delay from overflowing
+			delay = min(delay*2, maxDelay)
+		}
+		result = run(opts.Options)
+		if result.Status != StatusError {
+			return result
+		}
+	}
+	result.Message = fmt.Sprintf("%s (after %d attempts)", result.Message, attempts)
+	return result
+}
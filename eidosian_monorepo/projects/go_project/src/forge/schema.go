@@ -0,0 +1,67 @@
+package forge
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// schemaDraft identifies the JSON Schema dialect Schema produces
+const schemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// Schema returns a JSON Schema describing the JSON encoding of Result. It is
+// derived from the struct definition and Statuses, so it tracks both.
+func Schema() ([]byte, error) {
+	properties := make(map[string]any)
+	required := []string{}
+	t := reflect.TypeOf(Result{})
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" || !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		prop, err := schemaType(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("schema field %s: %w", field.Name, err)
+		}
+		if name == "status" {
+			prop["enum"] = Statuses
+		}
+		properties[name] = prop
+		if !strings.Contains(opts, "omitempty") {
+			required = append(required, name)
+		}
+	}
+	return json.MarshalIndent(map[string]any{
+		"$schema":    schemaDraft,
+		"title":      t.Name(),
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}, "", "  ")
+}
+
+// schemaType describes a Go type as a JSON Schema type
+func schemaType(t reflect.Type) (map[string]any, error) {
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}, nil
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}, nil
+	case reflect.Map:
+		return map[string]any{"type": "object"}, nil
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array"}, nil
+	}
+	return nil, fmt.Errorf("unsupported kind %s", t.Kind())
+}
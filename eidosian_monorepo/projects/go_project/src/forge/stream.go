@@ -0,0 +1,48 @@
+package forge
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// flusher is implemented by buffered writers such as *bufio.Writer
+type flusher interface {
+	Flush() error
+}
+
+// StreamWriter emits results as JSON lines, one compact object per line, so
+// that consumers can parse a long-running session incrementally. It is safe
+// for concurrent use.
+type StreamWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewStreamWriter returns a StreamWriter that writes to w
+func NewStreamWriter(w io.Writer) *StreamWriter {
+	return &StreamWriter{w: w}
+}
+
+// Write encodes r as a single line and flushes the underlying writer if it
+// buffers output
+func (s *StreamWriter) Write(r Result) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("stream result: %w", err)
+	}
+	data = append(data, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.w.Write(data); err != nil {
+		return fmt.Errorf("stream result: %w", err)
+	}
+	if f, ok := s.w.(flusher); ok {
+		if err := f.Flush(); err != nil {
+			return fmt.Errorf("stream result: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+// Package width measures how many terminal columns text occupies
+package width
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// TabStop is the column multiple a tab advances to
+const TabStop = 8
+
+// variationEmoji is VS16, which asks for the emoji presentation of the
+// preceding character
+const variationEmoji = 0xFE0F
+
+// zeroWidthJoiner glues emoji into a single glyph, such as a family drawn
+// from its members
+const zeroWidthJoiner = 0x200D
+
+// skinTones are the Fitzpatrick modifiers, which recolor the emoji before
+// them rather than drawing a glyph of their own
+var skinTones = [][2]rune{{0x1F3FB, 0x1F3FF}}
+
+// regionalIndicators are the letters that pair up into a two-column flag
+var regionalIndicators = [][2]rune{{0x1F1E6, 0x1F1FF}}
+
+// wideRanges lists the East Asian Wide and Fullwidth code points, which
+// terminals draw across two columns
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},   // Hangul Jamo initial consonants
+	{0x231A, 0x231B},   // Watch, hourglass
+	{0x23E9, 0x23EC},   // Fast-forward and rewind buttons
+	{0x23F0, 0x23F0},   // Alarm clock
+	{0x23F3, 0x23F3},   // Hourglass with flowing sand
+	{0x25FD, 0x25FE},   // Medium small squares
+	{0x2614, 0x2615},   // Umbrella with rain, hot beverage
+	{0x2648, 0x2653},   // Zodiac signs
+	{0x267F, 0x267F},   // Wheelchair symbol
+	{0x2693, 0x2693},   // Anchor
+	{0x26A1, 0x26A1},   // High voltage
+	{0x26AA, 0x26AB},   // Medium circles
+	{0x26BD, 0x26BE},   // Soccer ball, baseball
+	{0x26C4, 0x26C5},   // Snowman, sun behind cloud
+	{0x26CE, 0x26CE},   // Ophiuchus
+	{0x26D4, 0x26D4},   // No entry
+	{0x26EA, 0x26EA},   // Church
+	{0x26F2, 0x26F3},   // Fountain, flag in hole
+	{0x26F5, 0x26F5},   // Sailboat
+	{0x26FA, 0x26FA},   // Tent
+	{0x26FD, 0x26FD},   // Fuel pump
+	{0x2705, 0x2705},   // Check mark button
+	{0x270A, 0x270B},   // Raised fist and hand
+	{0x2728, 0x2728},   // Sparkles
+	{0x274C, 0x274C},   // Cross mark
+	{0x274E, 0x274E},   // Cross mark button
+	{0x2753, 0x2755},   // Question and exclamation marks
+	{0x2757, 0x2757},   // Heavy exclamation mark
+	{0x2795, 0x2797},   // Heavy plus, minus and division signs
+	{0x27B0, 0x27B0},   // Curly loop
+	{0x27BF, 0x27BF},   // Double curly loop
+	{0x2B1B, 0x2B1C},   // Large squares
+	{0x2B50, 0x2B50},   // Star
+	{0x2B55, 0x2B55},   // Heavy large circle
+	{0x2E80, 0x303E},   // CJK radicals, Kangxi, CJK symbols and punctuation
+	{0x3041, 0x33FF},   // Hiragana, Katakana, Bopomofo, CJK compatibility
+	{0x3400, 0x4DBF},   // CJK Unified Ideographs Extension A
+	{0x4E00, 0x9FFF},   // CJK Unified Ideographs
+	{0xA000, 0xA4CF},   // Yi syllables and radicals
+	{0xAC00, 0xD7A3},   // Hangul syllables
+	{0xF900, 0xFAFF},   // CJK Compatibility Ideographs
+	{0xFE30, 0xFE4F},   // CJK Compatibility Forms
+	{0xFF00, 0xFF60},   // Fullwidth forms
+	{0xFFE0, 0xFFE6},   // Fullwidth signs
+	{0x1F004, 0x1F004}, // Mahjong red dragon
+	{0x1F0CF, 0x1F0CF}, // Joker
+	{0x1F18E, 0x1F18E}, // AB button
+	{0x1F191, 0x1F19A}, // Squared CL through VS
+	{0x1F200, 0x1F2FF}, // Enclosed ideographic supplement
+	{0x1F300, 0x1F64F}, // Miscellaneous symbols and pictographs, emoticons
+	{0x1F680, 0x1F6FF}, // Transport and map symbols
+	{0x1F7E0, 0x1F7EB}, // Large colored circles and squares
+	{0x1F900, 0x1F9FF}, // Supplemental symbols and pictographs
+	{0x1FA70, 0x1FAFF}, // Symbols and pictographs extended-A
+	{0x20000, 0x2FFFD}, // CJK Unified Ideographs Extensions B-F
+	{0x30000, 0x3FFFD}, // CJK Unified Ideographs Extension G
+}
+
+// Rune reports the number of columns r occupies. Combining marks and other
+// invisible format characters occupy none.
+func Rune(r rune) int {
+	switch {
+	case inRanges(r, wideRanges):
+		return 2
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	}
+	return 1
+}
+
+// String reports the number of columns s occupies. Escape sequences take up
+// no columns and everything else is measured a cluster at a time with
+// Cluster.
+func String(s string) int {
+	n := 0
+	for i := 0; i < len(s); {
+		if e := EscapeLen(s[i:]); e > 0 {
+			i += e
+			continue
+		}
+		size, w := Cluster(s[i:], n)
+		n += w
+		i += size
+	}
+	return n
+}
+
+// Cluster returns the length in bytes and the width in columns of the
+// character at the start of s together with the marks drawn on top of it,
+// which callers must keep together when cutting text. col is the column the
+// cluster starts at, so that a tab advances to the next multiple of TabStop.
+// VS16 widens a narrow character to its two-column emoji presentation, and
+// characters joined by a ZWJ, and skin tone modifiers, are drawn as part of
+// the emoji before them and add nothing to its width. A pair of regional
+// indicators is one flag. s must not start with an escape sequence.
+func Cluster(s string, col int) (size, w int) {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == '\t' {
+		return size, TabStop - col%TabStop
+	}
+	w = Rune(r)
+	if inRanges(r, regionalIndicators) {
+		if next, n := utf8.DecodeRuneInString(s[size:]); inRanges(next, regionalIndicators) {
+			size += n
+			w = 2
+		}
+	}
+	for size < len(s) {
+		next, n := utf8.DecodeRuneInString(s[size:])
+		switch {
+		case next == variationEmoji:
+			if w == 1 {
+				w = 2
+			}
+		case next == zeroWidthJoiner && w == 2:
+			if joined, m := utf8.DecodeRuneInString(s[size+n:]); m > 0 && !unicode.IsControl(joined) {
+				n += m
+			}
+		case w == 2 && inRanges(next, skinTones):
+		case Rune(next) == 0:
+		default:
+			return size, w
+		}
+		size += n
+	}
+	return size, w
+}
+
+// EscapeLen returns the length in bytes of the escape sequence at the start
+// of s, or zero if s does not start with one. CSI sequences run to their
+// final byte and OSC sequences to a BEL or ST terminator; an unterminated
+// sequence extends to the end of s.
+func EscapeLen(s string) int {
+	if len(s) < 2 || s[0] != 0x1b {
+		return 0
+	}
+	switch s[1] {
+	case '[':
+		for i := 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1
+			}
+		}
+		return len(s)
+	case ']':
+		for i := 2; i < len(s); i++ {
+			if s[i] == 0x07 {
+				return i + 1
+			}
+			if s[i] == 0x1b && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+		return len(s)
+	default:
+		_, size := utf8.DecodeRuneInString(s[1:])
+		return 1 + size
+	}
+}
+
+// inRanges reports whether r falls in one of the sorted inclusive ranges
+func inRanges(r rune, ranges [][2]rune) bool {
+	if r < ranges[0][0] || r > utf8.MaxRune {
+		return false
+	}
+	lo, hi := 0, len(ranges)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		switch {
+		case r < ranges[mid][0]:
+			hi = mid
+		case r > ranges[mid][1]:
+			lo = mid + 1
+		default:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,18 @@
+package width
+
+import "testing"
+
+// inRanges binary-searches its tables, so every one must stay sorted and
+// free of overlapping or duplicate entries
+func TestRangesSorted(t *testing.T) {
+	for name, ranges := range map[string][][2]rune{"wideRanges": wideRanges, "skinTones": skinTones, "regionalIndicators": regionalIndicators} {
+		for i, r := range ranges {
+			if r[0] > r[1] {
+				t.Errorf("%s[%d] = %U-%U is inverted", name, i, r[0], r[1])
+			}
+			if i > 0 && r[0] <= ranges[i-1][1] {
+				t.Errorf("%s[%d] = %U-%U is out of order after %U-%U", name, i, r[0], r[1], ranges[i-1][0], ranges[i-1][1])
+			}
+		}
+	}
+}
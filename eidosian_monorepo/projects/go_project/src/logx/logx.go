@@ -0,0 +1,169 @@
+// Package logx wraps the standard logger with level filtering
+package logx
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Level is the severity of a log message
+type Level int
+
+// Log levels in increasing order of severity
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// EnvLevel names the environment variable that sets the default level
+const EnvLevel = "FORGE_LOG_LEVEL"
+
+var levelNames = [...]string{"DEBUG", "INFO", "WARN", "ERROR"}
+
+// String returns the upper-case name of l
+func (l Level) String() string {
+	if l < LevelDebug || l > LevelError {
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+	return levelNames[l]
+}
+
+// ParseLevel looks up a level by name, ignoring case; "warning" is accepted
+// as an alias for warn
+func ParseLevel(name string) (Level, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "debug":
+		return LevelDebug, nil
+	case "info":
+		return LevelInfo, nil
+	case "warn", "warning":
+		return LevelWarn, nil
+	case "error":
+		return LevelError, nil
+	}
+	return LevelInfo, fmt.Errorf("unknown log level %q", name)
+}
+
+// Logger writes messages at or above a minimum level. Errors go to their own
+// writer so they can be kept on stderr while other output is redirected.
+type Logger struct {
+	level   Level
+	loggers [len(levelNames)]*log.Logger
+}
+
+// New creates a logger writing debug, info and warn messages to out and
+// error messages to errOut
+func New(out, errOut io.Writer, level Level) *Logger {
+	l := &Logger{level: level}
+	for lvl := range l.loggers {
+		w := out
+		if Level(lvl) == LevelError {
+			w = errOut
+		}
+		l.loggers[lvl] = log.New(w, levelNames[lvl]+" ", log.LstdFlags|log.Lmsgprefix)
+	}
+	return l
+}
+
+// FromEnv creates a logger writing to stderr at the level named by
+// FORGE_LOG_LEVEL, defaulting to info when it is unset or invalid
+func FromEnv() *Logger {
+	return FromEnvTo(os.Stderr, os.Stderr)
+}
+
+// FromEnvTo is like FromEnv but writes to out and errOut as New does. An
+// invalid level is reported as a warning to out.
+func FromEnvTo(out, errOut io.Writer) *Logger {
+	level := LevelInfo
+	name := os.Getenv(EnvLevel)
+	var parseErr error
+	if name != "" {
+		level, parseErr = ParseLevel(name)
+	}
+	l := New(out, errOut, level)
+	if parseErr != nil {
+		l.Warn("%s: %v", EnvLevel, parseErr)
+	}
+	return l
+}
+
+// Level returns the minimum level l writes
+func (l *Logger) Level() Level {
+	return l.level
+}
+
+// Debug logs a debug message
+func (l *Logger) Debug(format string, args ...any) {
+	l.logf(LevelDebug, format, args...)
+}
+
+// Info logs an informational message
+func (l *Logger) Info(format string, args ...any) {
+	l.logf(LevelInfo, format, args...)
+}
+
+// Warn logs a warning
+func (l *Logger) Warn(format string, args ...any) {
+	l.logf(LevelWarn, format, args...)
+}
+
+// Error logs an error
+func (l *Logger) Error(format string, args ...any) {
+	l.logf(LevelError, format, args...)
+}
+
+// logf writes a message when level is at or above the logger's threshold
+func (l *Logger) logf(level Level, format string, args ...any) {
+	if level < l.level {
+		return
+	}
+	l.loggers[level].Output(3, fmt.Sprintf(format, args...))
+}
+
+// std is created on first use so that importing the package has no side
+// effects, such as warning about an invalid FORGE_LOG_LEVEL
+var (
+	stdOnce sync.Once
+	std     *Logger
+)
+
+// Default returns the package-level logger used by Debug, Info, Warn and
+// Error, creating it with FromEnv on first use
+func Default() *Logger {
+	stdOnce.Do(func() {
+		std = FromEnv()
+	})
+	return std
+}
+
+// SetDefault replaces the package-level logger
+func SetDefault(l *Logger) {
+	stdOnce.Do(func() {})
+	std = l
+}
+
+// Debug logs a debug message to the default logger
+func Debug(format string, args ...any) {
+	Default().logf(LevelDebug, format, args...)
+}
+
+// Info logs an informational message to the default logger
+func Info(format string, args ...any) {
+	Default().logf(LevelInfo, format, args...)
+}
+
+// Warn logs a warning to the default logger
+func Warn(format string, args ...any) {
+	Default().logf(LevelWarn, format, args...)
+}
+
+// Error logs an error to the default logger
+func Error(format string, args ...any) {
+	Default().logf(LevelError, format, args...)
+}
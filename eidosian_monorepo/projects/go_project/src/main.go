@@ -2,35 +2,155 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
+	"flag"
 	"fmt"
-	"log"
+	"io"
 	"os"
-)
 
-// Result represents the output of the run function
-type Result struct {
-	Status  string `json:"status"`
-	Message string `json:"message"`
-}
+	"go_project/src/color"
+	"go_project/src/forge"
+	"go_project/src/logx"
+	"go_project/src/term"
+	"go_project/src/theme"
+)
 
-// Run executes the main functionality of the project
-func Run() Result {
-	return Result{
-		Status:  "success",
-		Message: "Hello from Go project!",
+// resultFromInput re-renders a result piped in on stdin, running the project
+// when stdin is a terminal or carries no input
+func resultFromInput(ctx context.Context, stdin *os.File, opts forge.Options) (forge.Result, error) {
+	if term.IsTerminal(stdin) {
+		return forge.RunContextWithOptions(ctx, opts), nil
+	}
+	result, err := forge.ReadResult(stdin)
+	if errors.Is(err, io.EOF) {
+		return forge.RunContextWithOptions(ctx, opts), nil
 	}
+	if err != nil {
+		return forge.Result{}, fmt.Errorf("stdin: %w", err)
+	}
+	return result, nil
 }
 
+// exit ends the process; tests replace it to observe exit codes
+var exit = os.Exit
+
+// stdin supplies piped results; tests replace it to control input
+var stdin = os.Stdin
+
 func main() {
-	result := Run()
-	
-	// Convert result to JSON
-	jsonData, err := json.Marshal(result)
+	exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the forge command with args, writing to stdout and stderr,
+// and returns the process exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	ctx, stop := interruptContext(stdout)
+	defer stop()
+	log := logx.FromEnvTo(stderr, stderr)
+
+	flags := flag.NewFlagSet("forge", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	formatFlag := flags.String("format", "", "output format: human, json or yaml (default $FORGE_FORMAT)")
+	themePath := flags.String("theme", "", "path to a JSON theme file")
+	showVersion := flags.Bool("version", false, "print build information and exit")
+	dryRun := flags.Bool("dry-run", false, "report what would be done without doing it")
+	var quiet bool
+	flags.BoolVar(&quiet, "quiet", false, "print nothing unless the run fails")
+	flags.BoolVar(&quiet, "q", false, "shorthand for --quiet")
+	noColor := flags.Bool("no-color", false, "strip escape sequences from the output")
+	timestamps := flags.Bool("timestamps", false, "prefix each line of human output with an RFC 3339 timestamp")
+	showPalette := flags.Bool("palette", false, "print the 256-color palette and exit")
+	showSchema := flags.Bool("schema", false, "print the JSON Schema of a result and exit")
+	outputPath := flags.String("output", "", "write the result to this file instead of stdout")
+	forceColor := flags.Bool("force-color", false, "emit colors even when not writing to a terminal")
+	ascii := flags.Bool("ascii", false, "mark statuses with ASCII such as [OK] instead of symbols")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return forge.ExitOK
+		}
+		return forge.ExitUsage
+	}
+
+	if *showVersion {
+		if err := printVersion(stdout, term.IsTerminalWriter(stdout)); err != nil {
+			log.Error("Error printing version: %v", err)
+			return forge.ExitError
+		}
+		return forge.ExitOK
+	}
+	if *showSchema {
+		schema, err := forge.Schema()
+		if err != nil {
+			log.Error("Error generating schema: %v", err)
+			return forge.ExitError
+		}
+		fmt.Fprintf(stdout, "%s\n", schema)
+		return forge.ExitOK
+	}
+	// The color flags only apply to this run
+	defer color.SetMode(color.CurrentMode())
+	if *forceColor {
+		color.SetMode(color.Always)
+	}
+	if *noColor {
+		color.SetMode(color.Never)
+	}
+	if *showPalette {
+		fmt.Fprint(stdout, renderPalette(term.Width()))
+		return forge.ExitOK
+	}
+	isTTY := *outputPath == "" && term.IsTerminalWriter(stdout)
+	format, err := forge.ResolveFormat(*formatFlag, os.Getenv(forge.EnvFormat), isTTY)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return forge.ExitUsage
+	}
+
+	th := theme.DefaultTheme()
+	if *themePath != "" {
+		loaded, err := theme.LoadTheme(*themePath)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return forge.ExitUsage
+		}
+		th = loaded
+	}
+
+	result, err := resultFromInput(ctx, stdin, forge.Options{DryRun: *dryRun})
 	if err != nil {
-		log.Fatalf("Error marshalling result: %v", err)
+		fmt.Fprintln(stderr, err)
+		return forge.ExitError
+	}
+	if ctx.Err() != nil {
+		restoreTerminal(stdout)
+		result.Code = forge.ExitInterrupted
+	}
+	if err := result.Validate(); err != nil {
+		log.Error("internal error: invalid result: %v", err)
+		return forge.ExitError
+	}
+
+	opts := outputOptions{
+		Format:     format,
+		Theme:      th,
+		Icons:      isTTY,
+		ASCII:      *ascii,
+		Quiet:      quiet,
+		NoColor:    *noColor,
+		ForceColor: *forceColor,
+		Timestamps: *timestamps,
+	}
+	if *outputPath != "" {
+		if err := writeResultFile(*outputPath, stderr, result, opts); err != nil {
+			fmt.Fprintln(stderr, err)
+			return forge.ExitError
+		}
+		return result.Code
+	}
+	if err := writeResult(stdout, stderr, result, opts); err != nil {
+		log.Error("Error writing result: %v", err)
+		return forge.ExitError
 	}
-	
-	fmt.Printf("Result: %s\n", string(jsonData))
-	os.Exit(0)
+	return result.Code
 }
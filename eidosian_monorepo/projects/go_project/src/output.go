@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"go_project/src/forge"
+	"go_project/src/theme"
+)
+
+// outputOptions controls how writeResult renders a result
+type outputOptions struct {
+	Format string
+	Theme  *theme.Theme
+	// Icons prefixes human output with a status icon
+	Icons bool
+	// ASCII draws the status icons as plain-text markers
+	ASCII bool
+	// Quiet drops successful results and sends failures to stderr
+	Quiet bool
+	// NoColor strips escape sequences from the rendered output
+	NoColor bool
+	// ForceColor keeps escape sequences in output written to a file
+	ForceColor bool
+	// Timestamps prefixes each line of human output with the time;
+	// structured formats are left untouched
+	Timestamps bool
+	// Now is the clock used for timestamps; nil uses time.Now
+	Now func() time.Time
+}
+
+// writeResult renders r in opts.Format to stdout, or to stderr when quiet
+// mode is on and r is a failure
+func writeResult(stdout, stderr io.Writer, r forge.Result, opts outputOptions) error {
+	w := stdout
+	if opts.Quiet {
+		if r.Code == forge.ExitOK {
+			return nil
+		}
+		w = stderr
+	}
+
+	return forge.Render(w, r, forge.RenderOptions{
+		Format:     opts.Format,
+		Theme:      opts.Theme,
+		Icons:      opts.Icons,
+		ASCII:      opts.ASCII,
+		NoColor:    opts.NoColor,
+		Timestamps: opts.Timestamps,
+		Now:        opts.Now,
+	})
+}
+
+// writeResultFile renders r into the file at path, creating or truncating
+// it. Escape sequences are stripped unless opts.ForceColor is set; quiet
+// failures still go to stderr, and in quiet mode the file is not touched.
+func writeResultFile(path string, stderr io.Writer, r forge.Result, opts outputOptions) error {
+	if !opts.ForceColor {
+		opts.NoColor = true
+	}
+	if opts.Quiet {
+		return writeResult(io.Discard, stderr, r, opts)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("output: %w", err)
+	}
+	if err := writeResult(f, stderr, r, opts); err != nil {
+		f.Close()
+		return fmt.Errorf("output %s: %w", path, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("output: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"go_project/src/color"
+	"go_project/src/forge"
+)
+
+func TestWriteResultQuietSuccessIsSilent(t *testing.T) {
+	ok := forge.Result{Status: forge.StatusSuccess, Message: "done", Code: forge.ExitOK}
+	for _, format := range []string{forge.FormatHuman, forge.FormatJSON, forge.FormatYAML} {
+		var stdout, stderr bytes.Buffer
+		if err := writeResult(&stdout, &stderr, ok, outputOptions{Format: format, Quiet: true}); err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		if stdout.Len() != 0 || stderr.Len() != 0 {
+			t.Errorf("%s: quiet success wrote stdout %q, stderr %q", format, stdout.String(), stderr.String())
+		}
+	}
+}
+
+func TestWriteResultQuietFailureGoesToStderr(t *testing.T) {
+	color.SetMode(color.Never)
+	defer color.SetMode(color.Auto)
+
+	failed := forge.Result{Status: forge.StatusError, Message: "boom", Code: forge.ExitError}
+	var stdout, stderr bytes.Buffer
+	if err := writeResult(&stdout, &stderr, failed, outputOptions{Format: forge.FormatHuman, Quiet: true}); err != nil {
+		t.Fatal(err)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("quiet failure wrote %q to stdout", stdout.String())
+	}
+	if want := "error: boom\n"; stderr.String() != want {
+		t.Errorf("stderr = %q, want %q", stderr.String(), want)
+	}
+}
+
+func TestWriteResultJSON(t *testing.T) {
+	ok := forge.Result{Status: forge.StatusSuccess, Message: "done", Code: forge.ExitOK}
+	var stdout, stderr bytes.Buffer
+	if err := writeResult(&stdout, &stderr, ok, outputOptions{Format: forge.FormatJSON}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "Result: {\"status\":\"success\",\"message\":\"done\",\"code\":0}\n"; stdout.String() != want {
+		t.Errorf("stdout = %q, want %q", stdout.String(), want)
+	}
+}
+
+func TestWriteResultNoColorStripsEscapes(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+
+	r := forge.Result{Status: forge.StatusSuccess, Message: "**built** `forge`", Code: forge.ExitOK}
+	var stdout, stderr bytes.Buffer
+	if err := writeResult(&stdout, &stderr, r, outputOptions{Format: forge.FormatHuman, NoColor: true}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "success: built forge\n"; stdout.String() != want {
+		t.Errorf("stdout = %q, want %q", stdout.String(), want)
+	}
+}
+
+func TestWriteResultTimestampsHumanOnly(t *testing.T) {
+	color.SetMode(color.Never)
+	defer color.SetMode(color.Auto)
+	t.Setenv("COLUMNS", "80")
+
+	now := func() time.Time { return time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC) }
+	r := forge.Result{Status: forge.StatusSuccess, Message: "done", Code: forge.ExitOK}.WithField("host", "db-1")
+
+	var stdout, stderr bytes.Buffer
+	opts := outputOptions{Format: forge.FormatHuman, Timestamps: true, Now: now}
+	if err := writeResult(&stdout, &stderr, r, opts); err != nil {
+		t.Fatal(err)
+	}
+	want := "2024-05-01T12:00:00Z success: done\n2024-05-01T12:00:00Z   host: db-1\n"
+	if stdout.String() != want {
+		t.Errorf("stdout = %q, want %q", stdout.String(), want)
+	}
+
+	stdout.Reset()
+	opts.Format = forge.FormatJSON
+	if err := writeResult(&stdout, &stderr, r, opts); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(stdout.String(), "Result: {") {
+		t.Errorf("expected JSON output without timestamps, got %q", stdout.String())
+	}
+}
+
+func TestWriteResultFileStripsEscapes(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+
+	path := filepath.Join(t.TempDir(), "result.txt")
+	if err := os.WriteFile(path, []byte("stale contents that should be truncated\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	r := forge.Result{Status: forge.StatusSuccess, Message: "**done**", Code: forge.ExitOK}
+	if err := writeResultFile(path, io.Discard, r, outputOptions{Format: forge.FormatHuman}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "success: done\n"; string(data) != want {
+		t.Errorf("file = %q, want %q", data, want)
+	}
+	if bytes.ContainsRune(data, '\x1b') {
+		t.Errorf("file contains escape sequences: %q", data)
+	}
+}
+
+func TestWriteResultFileForceColor(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+
+	path := filepath.Join(t.TempDir(), "result.txt")
+	r := forge.Result{Status: forge.StatusSuccess, Message: "done", Code: forge.ExitOK}
+	if err := writeResultFile(path, io.Discard, r, outputOptions{Format: forge.FormatHuman, ForceColor: true}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "\x1b[32msuccess\x1b[0m: done\n"; string(data) != want {
+		t.Errorf("file = %q, want %q", data, want)
+	}
+}
+
+func TestWriteResultFileJSONAndErrors(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "result.json")
+	r := forge.Result{Status: forge.StatusError, Message: "boom", Code: forge.ExitError}
+	if err := writeResultFile(path, io.Discard, r, outputOptions{Format: forge.FormatJSON}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Result: {\"status\":\"error\",\"message\":\"boom\",\"code\":1}\n"; string(data) != want {
+		t.Errorf("file = %q, want %q", data, want)
+	}
+
+	err = writeResultFile(filepath.Join(dir, "missing", "result.json"), io.Discard, r, outputOptions{Format: forge.FormatJSON})
+	if err == nil || !strings.HasPrefix(err.Error(), "output: ") {
+		t.Errorf("expected an output error for a missing directory, got %v", err)
+	}
+}
+
+func TestWriteResultFileQuietLeavesFileAlone(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "result.txt")
+	if err := os.WriteFile(path, []byte("previous run\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stderr bytes.Buffer
+	ok := forge.Result{Status: forge.StatusSuccess, Message: "done", Code: forge.ExitOK}
+	if err := writeResultFile(path, &stderr, ok, outputOptions{Format: forge.FormatHuman, Quiet: true}); err != nil {
+		t.Fatal(err)
+	}
+	failed := forge.Result{Status: forge.StatusError, Message: "boom", Code: forge.ExitError}
+	if err := writeResultFile(path, &stderr, failed, outputOptions{Format: forge.FormatHuman, Quiet: true}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "previous run\n"; string(data) != want {
+		t.Errorf("file = %q, want it untouched as %q", data, want)
+	}
+	if want := "error: boom\n"; stderr.String() != want {
+		t.Errorf("stderr = %q, want %q", stderr.String(), want)
+	}
+}
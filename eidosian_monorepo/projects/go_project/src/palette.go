@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"go_project/src/color"
+)
+
+// swatchWidth is the number of columns each palette entry occupies
+const swatchWidth = 5
+
+// renderPalette lays out all 256 palette entries as swatches showing their
+// index on their own background, as many per row as fit in width. With
+// color disabled it lists the indices one per line instead.
+func renderPalette(width int) string {
+	var b strings.Builder
+	if !color.Enabled() {
+		for n := 0; n < 256; n++ {
+			fmt.Fprintf(&b, "%3d\n", n)
+		}
+		return b.String()
+	}
+
+	perRow := max(width/swatchWidth, 1)
+	for n := 0; n < 256; n++ {
+		bg := color.Indexed(uint8(n))
+		b.WriteString(color.Colorize(fmt.Sprintf(" %3d ", n), color.ContrastColor(bg), bg))
+		if (n+1)%perRow == 0 || n == 255 {
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
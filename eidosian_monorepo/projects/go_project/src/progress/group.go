@@ -0,0 +1,127 @@
+package progress
+
+import (
+	"io"
+	"strings"
+	"sync"
+
+	"go_project/src/term"
+	"go_project/src/text"
+)
+
+// ProgressGroup stacks several progress bars, one per row, and redraws each
+// row in place as its bar advances. It is safe for concurrent use, so bars
+// may be advanced from different goroutines through Increment.
+type ProgressGroup struct {
+	mu     sync.Mutex
+	w      io.Writer
+	cursor *term.Cursor
+	rows   []*groupRow
+
+	// Width is the width of each row in columns; zero uses the terminal width
+	Width int
+	// Interactive redraws rows in place using cursor movement; otherwise a
+	// line is printed as each bar completes
+	Interactive bool
+}
+
+// groupRow is one labelled bar of a group
+type groupRow struct {
+	label string
+	bar   *ProgressBar
+}
+
+// NewProgressGroup creates an empty group drawing to w
+func NewProgressGroup(w io.Writer) *ProgressGroup {
+	cursor := term.NewCursor(w)
+	return &ProgressGroup{w: w, cursor: cursor, Interactive: cursor.Enabled}
+}
+
+// Add appends a bar for total steps on a new row below the others, headed by
+// label, and returns it. The bar must only be advanced through Increment.
+func (g *ProgressGroup) Add(label string, total int) *ProgressBar {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	bar := New(total)
+	g.rows = append(g.rows, &groupRow{label: label, bar: bar})
+	if g.Interactive {
+		// The cursor rests below the last row, which is where the new row goes
+		io.WriteString(g.w, g.render(len(g.rows)-1)+"\n")
+		bar.throttle().force()
+	}
+	return bar
+}
+
+// Increment advances bar by one step and repaints its row. Repaints are
+// throttled as for a standalone bar, and the step completing a bar always
+// paints.
+func (g *ProgressGroup) Increment(bar *ProgressBar) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	i := g.index(bar)
+	if i < 0 || (bar.total != 0 && bar.current >= bar.total) {
+		return
+	}
+	bar.current++
+	completed := bar.current == bar.total
+
+	if !g.Interactive {
+		if completed {
+			io.WriteString(g.w, g.rows[i].label+" "+bar.label()+"\n")
+		}
+		return
+	}
+	if completed {
+		bar.throttle().force()
+	} else if !bar.throttle().ready() {
+		return
+	}
+	g.repaint(i)
+}
+
+// Render returns the current rows of the group, one line per bar, without
+// escape sequences or a trailing newline
+func (g *ProgressGroup) Render() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	lines := make([]string, len(g.rows))
+	for i := range g.rows {
+		lines[i] = g.render(i)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// repaint redraws row i in place and returns the cursor below the last row
+func (g *ProgressGroup) repaint(i int) {
+	up := len(g.rows) - i
+	// Interactive may have been changed since the cursor was created
+	g.cursor.Enabled = g.Interactive
+	g.cursor.MoveUp(up)
+	g.cursor.ClearLine()
+	io.WriteString(g.w, g.render(i))
+	g.cursor.MoveDown(up)
+	io.WriteString(g.w, "\r")
+}
+
+// render draws row i as its label followed by an interactive bar filling
+// the rest of the row
+func (g *ProgressGroup) render(i int) string {
+	row := g.rows[i]
+	width := g.Width
+	if width <= 0 {
+		width = term.Width() - 1
+	}
+	row.bar.Interactive = true
+	row.bar.Width = max(width-text.DisplayWidth(row.label)-1, 1)
+	return row.label + " " + strings.TrimPrefix(row.bar.Render(), "\r")
+}
+
+// index returns the row of bar, or -1 if it is not in the group
+func (g *ProgressGroup) index(bar *ProgressBar) int {
+	for i, row := range g.rows {
+		if row.bar == bar {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,164 @@
+// Package progress renders indicators for long-running work
+package progress
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"go_project/src/term"
+)
+
+// Glyphs used to draw the bar
+const (
+	filledCell = "█"
+	emptyCell  = "░"
+)
+
+// indeterminateCells is the length of the block that sweeps across a bar
+// whose total is unknown
+const indeterminateCells = 3
+
+// ProgressBar tracks completion of a fixed number of steps
+type ProgressBar struct {
+	total   int
+	current int
+
+	// Width is the rendered width in columns; zero uses the terminal width
+	Width int
+	// Interactive redraws the bar in place with a carriage return instead of
+	// emitting one percentage line per render
+	Interactive bool
+
+	// Output receives the repaints made by Increment and Draw; nil disables
+	// them, leaving callers to write Render themselves. Bars created with
+	// NewTo detect Interactive from it; New detects it from stdout.
+	Output io.Writer
+	// RedrawInterval is the minimum time between repaints made by Increment;
+	// zero uses DefaultRedrawInterval, or DefaultLogInterval when the bar is
+	// not interactive
+	RedrawInterval time.Duration
+	// Now is the clock used for throttling; nil uses time.Now
+	Now func() time.Time
+
+	redraw throttle
+	// shown is the percentage last painted, which a bar that is not
+	// interactive must move past before it prints another line
+	shown int
+}
+
+// New creates a bar for total steps whose output the caller writes to
+// stdout. A total of zero renders an indeterminate bar.
+func New(total int) *ProgressBar {
+	return &ProgressBar{
+		total:       max(total, 0),
+		Interactive: term.DetectCapabilities(os.Stdout).Cursor,
+	}
+}
+
+// NewTo creates a bar for total steps that repaints itself to w, redrawing
+// in place only when w is a terminal that supports it
+func NewTo(w io.Writer, total int) *ProgressBar {
+	return &ProgressBar{
+		total:       max(total, 0),
+		Interactive: term.DetectCapabilities(w).Cursor,
+		Output:      w,
+	}
+}
+
+// Increment advances the bar by one step, stopping at the total, and
+// repaints it to Output. Repaints are throttled to one per RedrawInterval,
+// coalescing the steps in between, except that the step completing the bar
+// always paints. A bar that is not interactive also skips lines that would
+// repeat the whole percentage already printed.
+func (p *ProgressBar) Increment() {
+	if p.total != 0 && p.current >= p.total {
+		return
+	}
+	p.current++
+	if p.Output == nil {
+		return
+	}
+	if p.current == p.total {
+		p.Draw()
+		return
+	}
+	if !p.Interactive && p.total != 0 && p.Percent() == p.shown {
+		return
+	}
+	if p.throttle().ready() {
+		p.shown = p.Percent()
+		io.WriteString(p.Output, p.Render())
+	}
+}
+
+// Draw repaints the bar to Output immediately, ignoring the throttle
+func (p *ProgressBar) Draw() error {
+	if p.Output == nil {
+		return nil
+	}
+	p.throttle().force()
+	p.shown = p.Percent()
+	_, err := io.WriteString(p.Output, p.Render())
+	return err
+}
+
+// throttle returns the repaint throttle configured from the exported fields
+func (p *ProgressBar) throttle() *throttle {
+	p.redraw.interval, p.redraw.now = p.RedrawInterval, p.Now
+	if p.redraw.interval <= 0 && !p.Interactive {
+		p.redraw.interval = DefaultLogInterval
+	}
+	return &p.redraw
+}
+
+// Percent reports completion in the range 0-100, or -1 when the total is
+// unknown
+func (p *ProgressBar) Percent() int {
+	if p.total == 0 {
+		return -1
+	}
+	return p.current * 100 / p.total
+}
+
+// Render returns the current state of the bar. Interactive bars start with a
+// carriage return so that writing them repeatedly redraws a single line;
+// otherwise a newline-terminated percentage line is returned.
+func (p *ProgressBar) Render() string {
+	label := p.label()
+	if !p.Interactive {
+		return label + "\n"
+	}
+
+	width := p.Width
+	if width <= 0 {
+		// Leave the last column free so the terminal never wraps the line
+		width = term.Width() - 1
+	}
+	// Two brackets and the space before the label
+	cells := max(width-len(label)-3, 1)
+	return "\r[" + p.bar(cells) + "] " + label
+}
+
+// label describes progress as text, e.g. "40% (4/10)"
+func (p *ProgressBar) label() string {
+	if p.total == 0 {
+		return fmt.Sprintf("(%d/?)", p.current)
+	}
+	return fmt.Sprintf("%d%% (%d/%d)", p.Percent(), p.current, p.total)
+}
+
+// bar draws the filled and empty cells of a bar cells wide
+func (p *ProgressBar) bar(cells int) string {
+	if p.total == 0 {
+		block := min(indeterminateCells, cells)
+		start := p.current % (cells - block + 1)
+		return strings.Repeat(emptyCell, start) +
+			strings.Repeat(filledCell, block) +
+			strings.Repeat(emptyCell, cells-start-block)
+	}
+	filled := cells * p.current / p.total
+	return strings.Repeat(filledCell, filled) + strings.Repeat(emptyCell, cells-filled)
+}
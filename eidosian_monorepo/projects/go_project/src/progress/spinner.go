@@ -0,0 +1,111 @@
+package progress
+
+import (
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"go_project/src/term"
+)
+
+// DefaultFrames is the braille animation used when no frames are configured
+var DefaultFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// DefaultInterval is the delay between frames when none is configured
+const DefaultInterval = 100 * time.Millisecond
+
+// SpinnerOptions configures a Spinner
+type SpinnerOptions struct {
+	// Frames are drawn in order, wrapping around; defaults to DefaultFrames
+	Frames []string
+	// Interval between frames; defaults to DefaultInterval and is never
+	// shorter than DefaultRedrawInterval
+	Interval time.Duration
+	// Output receives the frames; defaults to os.Stdout
+	Output io.Writer
+	// Ticker returns a channel delivering a tick every interval and a function
+	// that stops it; defaults to a time.Ticker
+	Ticker func(time.Duration) (<-chan time.Time, func())
+}
+
+// Spinner animates a single line while work of unknown length runs
+type Spinner struct {
+	opts SpinnerOptions
+
+	// Interactive enables drawing; a spinner that is not interactive writes
+	// nothing so that logs are not flooded with frames
+	Interactive bool
+
+	mu      sync.Mutex
+	running bool
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewSpinner creates a stopped spinner, filling in defaults for unset options
+func NewSpinner(opts SpinnerOptions) *Spinner {
+	if len(opts.Frames) == 0 {
+		opts.Frames = DefaultFrames
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+	opts.Interval = max(opts.Interval, DefaultRedrawInterval)
+	if opts.Output == nil {
+		opts.Output = os.Stdout
+	}
+	if opts.Ticker == nil {
+		opts.Ticker = newTicker
+	}
+	return &Spinner{opts: opts, Interactive: term.DetectCapabilities(opts.Output).Cursor}
+}
+
+// newTicker adapts time.Ticker to SpinnerOptions.Ticker
+func newTicker(d time.Duration) (<-chan time.Time, func()) {
+	t := time.NewTicker(d)
+	return t.C, t.Stop
+}
+
+// Start begins animating in a background goroutine. Starting a running
+// spinner has no effect.
+func (s *Spinner) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running || !s.Interactive {
+		return
+	}
+	s.running = true
+	s.done = make(chan struct{})
+	ticks, stop := s.opts.Ticker(s.opts.Interval)
+	s.wg.Add(1)
+	go s.spin(ticks, stop, s.done)
+}
+
+// spin draws a frame immediately and another on every tick until done closes
+func (s *Spinner) spin(ticks <-chan time.Time, stop func(), done <-chan struct{}) {
+	defer s.wg.Done()
+	defer stop()
+	for frame := 0; ; frame++ {
+		io.WriteString(s.opts.Output, "\r"+s.opts.Frames[frame%len(s.opts.Frames)])
+		select {
+		case <-ticks:
+		case <-done:
+			return
+		}
+	}
+}
+
+// Stop halts the animation and clears the spinner line. It is safe to call
+// more than once and on a spinner that was never started.
+func (s *Spinner) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.running {
+		return
+	}
+	s.running = false
+	close(s.done)
+	s.wg.Wait()
+	io.WriteString(s.opts.Output, "\r"+term.ClearToEnd())
+}
@@ -0,0 +1,47 @@
+package progress
+
+import "time"
+
+// DefaultRedrawInterval caps repaints at roughly 60 per second
+const DefaultRedrawInterval = time.Second / 60
+
+// DefaultLogInterval is the minimum time between the percentage lines a
+// bar prints when it cannot redraw in place, so that logs are not flooded
+const DefaultLogInterval = time.Second
+
+// throttle limits how often a widget repaints. The zero value allows one
+// paint per DefaultRedrawInterval using the system clock.
+type throttle struct {
+	interval time.Duration
+	now      func() time.Time
+	last     time.Time
+	painted  bool
+}
+
+// ready reports whether a repaint is due and, if so, records it. The first
+// call is always due.
+func (t *throttle) ready() bool {
+	interval := t.interval
+	if interval <= 0 {
+		interval = DefaultRedrawInterval
+	}
+	at := t.clock()
+	if t.painted && at.Sub(t.last) < interval {
+		return false
+	}
+	t.last, t.painted = at, true
+	return true
+}
+
+// force records a repaint that happened regardless of the interval
+func (t *throttle) force() {
+	t.last, t.painted = t.clock(), true
+}
+
+// clock returns the current time from the configured clock
+func (t *throttle) clock() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go_project/src/color"
+	"go_project/src/forge"
+)
+
+// withStdin makes run read contents as its piped input for the rest of the
+// test
+func withStdin(t *testing.T, contents string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "stdin")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := stdin
+	stdin = f
+	t.Cleanup(func() {
+		stdin = old
+		f.Close()
+	})
+}
+
+func TestRunSuccess(t *testing.T) {
+	withStdin(t, "")
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"--format", "json"}, &stdout, &stderr); code != forge.ExitOK {
+		t.Errorf("run() = %d, want %d; stderr %q", code, forge.ExitOK, stderr.String())
+	}
+	want := "Result: {\"status\":\"success\",\"message\":\"Hello from Go project!\",\"code\":0}\n"
+	if stdout.String() != want {
+		t.Errorf("stdout = %q, want %q", stdout.String(), want)
+	}
+	if stderr.Len() != 0 {
+		t.Errorf("stderr = %q, want nothing", stderr.String())
+	}
+}
+
+func TestRunPipedFailure(t *testing.T) {
+	withStdin(t, `Result: {"status":"error","message":"disk full"}`)
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"--format", "yaml"}, &stdout, &stderr); code != forge.ExitError {
+		t.Errorf("run() = %d, want %d", code, forge.ExitError)
+	}
+	if want := "status: \"error\"\nmessage: \"disk full\"\ncode: 1\n"; stdout.String() != want {
+		t.Errorf("stdout = %q, want %q", stdout.String(), want)
+	}
+}
+
+func TestRunBadFlag(t *testing.T) {
+	withStdin(t, "")
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"--bogus"}, &stdout, &stderr); code != forge.ExitUsage {
+		t.Errorf("run() = %d, want %d", code, forge.ExitUsage)
+	}
+	if !strings.Contains(stderr.String(), "flag provided but not defined: -bogus") {
+		t.Errorf("stderr = %q, want an unknown flag message", stderr.String())
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("stdout = %q, want nothing", stdout.String())
+	}
+
+	stderr.Reset()
+	if code := run([]string{"--format", "toml"}, &stdout, &stderr); code != forge.ExitUsage {
+		t.Errorf("run(--format toml) = %d, want %d", code, forge.ExitUsage)
+	}
+}
+
+func TestRunMarshalError(t *testing.T) {
+	withStdin(t, "")
+	err := forge.RegisterRenderer("broken", func(forge.Result) ([]byte, error) {
+		return nil, errors.New("renderer exploded")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { forge.UnregisterRenderer("broken") })
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"--format", "broken"}, &stdout, &stderr); code != forge.ExitError {
+		t.Errorf("run() = %d, want %d", code, forge.ExitError)
+	}
+	if !strings.Contains(stderr.String(), "marshal result: renderer exploded") {
+		t.Errorf("stderr = %q, want the marshaling error", stderr.String())
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("stdout = %q, want nothing", stdout.String())
+	}
+}
+
+func TestRunPaletteHonoursColorFlags(t *testing.T) {
+	withStdin(t, "")
+	t.Setenv("NO_COLOR", "")
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"--palette", "--force-color"}, &stdout, &stderr); code != forge.ExitOK {
+		t.Fatalf("run() = %d, want %d; stderr %q", code, forge.ExitOK, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "\x1b[") || !strings.Contains(stdout.String(), " 196 ") {
+		t.Errorf("expected --force-color to draw swatches when piped, got %.40q", stdout.String())
+	}
+
+	stdout.Reset()
+	if code := run([]string{"--palette", "--force-color", "--no-color"}, &stdout, &stderr); code != forge.ExitOK {
+		t.Fatalf("run() = %d, want %d; stderr %q", code, forge.ExitOK, stderr.String())
+	}
+	if strings.Contains(stdout.String(), "\x1b[") || !strings.HasPrefix(stdout.String(), "  0\n  1\n") {
+		t.Errorf("expected --no-color to list plain indices, got %.40q", stdout.String())
+	}
+}
+
+func TestRunRestoresColorMode(t *testing.T) {
+	withStdin(t, "")
+	defer color.SetMode(color.Auto)
+
+	color.SetMode(color.Always)
+	var stdout, stderr bytes.Buffer
+	run([]string{"--format", "json", "--no-color"}, &stdout, &stderr)
+	if got := color.CurrentMode(); got != color.Always {
+		t.Errorf("mode after --no-color = %v, want %v", got, color.Always)
+	}
+
+	color.SetMode(color.Never)
+	run([]string{"--format", "json", "--force-color"}, &stdout, &stderr)
+	if got := color.CurrentMode(); got != color.Never {
+		t.Errorf("mode after --force-color = %v, want %v", got, color.Never)
+	}
+}
+
+func TestRestoreTerminalSkipsNonTerminals(t *testing.T) {
+	t.Setenv("TERM", "xterm-256color")
+	var buf bytes.Buffer
+	restoreTerminal(&buf)
+	if buf.Len() != 0 {
+		t.Errorf("restoreTerminal() wrote %q to a buffer, want nothing", buf.String())
+	}
+}
+
+func TestRunReportsInvalidLogLevel(t *testing.T) {
+	withStdin(t, "")
+	t.Setenv("FORGE_LOG_LEVEL", "loud")
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"--format", "json"}, &stdout, &stderr); code != forge.ExitOK {
+		t.Errorf("run() = %d, want %d", code, forge.ExitOK)
+	}
+	if !strings.Contains(stderr.String(), "FORGE_LOG_LEVEL") {
+		t.Errorf("stderr = %q, want a warning about the log level", stderr.String())
+	}
+}
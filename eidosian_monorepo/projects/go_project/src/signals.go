@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go_project/src/forge"
+	"go_project/src/term"
+)
+
+// interruptContext returns a context that is canceled by the first SIGINT or
+// SIGTERM, letting the run wind down. A second signal exits immediately with
+// forge.ExitInterrupted, first restoring the terminal if stdout is one.
+// Calling stop releases the signal handlers.
+func interruptContext(stdout io.Writer) (ctx context.Context, stop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	done := make(chan struct{})
+	go func() {
+		defer signal.Stop(signals)
+		for interrupted := false; ; interrupted = true {
+			select {
+			case <-signals:
+			case <-done:
+				return
+			}
+			if interrupted {
+				restoreTerminal(stdout)
+				exit(forge.ExitInterrupted)
+				return
+			}
+			cancel()
+		}
+	}()
+	return ctx, func() {
+		close(done)
+		cancel()
+	}
+}
+
+// restoreTerminal undoes cursor and line state an interrupted render to
+// stdout may have left behind
+func restoreTerminal(stdout io.Writer) {
+	if term.DetectCapabilities(stdout).Cursor {
+		term.RestoreTerminal(stdout)
+	}
+}
@@ -0,0 +1,8 @@
+package table
+
+import "go_project/src/text"
+
+// DefinitionList renders aligned "key: value" pairs. It lives in the text
+// package so that renderers table depends on can use it too; this alias
+// keeps existing callers building.
+type DefinitionList = text.DefinitionList
@@ -0,0 +1,18 @@
+package table
+
+import (
+	"strconv"
+
+	"go_project/src/forge"
+)
+
+// FromResults builds a table with one row per result in set
+func FromResults(set *forge.ResultSet) *Table {
+	t := New()
+	t.SetHeader("Status", "Message", "Code")
+	t.SetAlignment(2, AlignRight)
+	for _, r := range set.Results {
+		t.AddRow(r.Status, r.Message, strconv.Itoa(r.Code))
+	}
+	return t
+}
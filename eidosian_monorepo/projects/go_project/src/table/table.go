@@ -0,0 +1,172 @@
+// Package table renders rows of text as aligned columns
+package table
+
+import (
+	"strings"
+
+	"go_project/src/term"
+	"go_project/src/text"
+)
+
+// Alignment positions a cell's text within its column
+type Alignment int
+
+// Column alignments
+const (
+	AlignLeft Alignment = iota
+	AlignRight
+	AlignCenter
+)
+
+// Column and separator glyphs
+const (
+	columnGap = " │ "
+	headerGap = "─┼─"
+	headerBar = "─"
+)
+
+// Table collects a header and rows and renders them with each column sized
+// to its widest cell. The zero value is an empty table.
+type Table struct {
+	header     []string
+	rows       [][]string
+	align      map[int]Alignment
+	noTruncate map[int]bool
+	limited    bool
+	maxWidth   int
+}
+
+// New creates an empty table
+func New() *Table {
+	return &Table{}
+}
+
+// SetHeader sets the header cells, drawn above a separator row
+func (t *Table) SetHeader(cells ...string) {
+	t.header = cells
+}
+
+// AddRow appends a row. Rows may have differing numbers of cells; missing
+// cells render empty.
+func (t *Table) AddRow(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// SetAlignment sets the alignment of column col, counting from zero
+func (t *Table) SetAlignment(col int, a Alignment) {
+	if t.align == nil {
+		t.align = make(map[int]Alignment)
+	}
+	t.align[col] = a
+}
+
+// SetMaxWidth limits the rendered width of the table to w columns by
+// truncating wide cells with an ellipsis. A w of zero or less limits the
+// table to the terminal width at the time it is rendered.
+func (t *Table) SetMaxWidth(w int) {
+	t.limited = true
+	t.maxWidth = w
+}
+
+// SetTruncate controls whether column col may be truncated to satisfy the
+// maximum width. Every column may be truncated unless disabled here.
+func (t *Table) SetTruncate(col int, enabled bool) {
+	if t.noTruncate == nil {
+		t.noTruncate = make(map[int]bool)
+	}
+	t.noTruncate[col] = !enabled
+}
+
+// Render lays out the table, one line per row, without a trailing newline
+func (t *Table) Render() string {
+	widths := t.columnWidths()
+	if len(widths) == 0 {
+		return ""
+	}
+	if t.limited {
+		t.shrink(widths)
+	}
+
+	var lines []string
+	if t.header != nil {
+		lines = append(lines, t.renderRow(t.header, widths))
+		bars := make([]string, len(widths))
+		for i, w := range widths {
+			bars[i] = strings.Repeat(headerBar, w)
+		}
+		lines = append(lines, strings.Join(bars, headerGap))
+	}
+	for _, row := range t.rows {
+		lines = append(lines, t.renderRow(row, widths))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// columnWidths returns the display width of the widest cell in each column
+func (t *Table) columnWidths() []int {
+	var widths []int
+	measure := func(cells []string) {
+		for i, cell := range cells {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], text.DisplayWidth(cell))
+		}
+	}
+	measure(t.header)
+	for _, row := range t.rows {
+		measure(row)
+	}
+	return widths
+}
+
+// shrink narrows truncatable columns, widest first, until the table fits
+// its maximum width or no column can give up another cell
+func (t *Table) shrink(widths []int) {
+	limit := t.maxWidth
+	if limit <= 0 {
+		limit = term.Width()
+	}
+	total := text.DisplayWidth(columnGap) * (len(widths) - 1)
+	for _, w := range widths {
+		total += w
+	}
+	minWidth := text.DisplayWidth(text.Ellipsis)
+	for ; total > limit; total-- {
+		widest := -1
+		for i, w := range widths {
+			if !t.noTruncate[i] && w > minWidth && (widest < 0 || w > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			return
+		}
+		widths[widest]--
+	}
+}
+
+// renderRow pads each cell of row to its column width
+func (t *Table) renderRow(row []string, widths []int) string {
+	cells := make([]string, len(widths))
+	for i, w := range widths {
+		var cell string
+		if i < len(row) {
+			cell = text.Truncate(row[i], w)
+		}
+		cells[i] = align(cell, w, t.align[i])
+	}
+	return strings.Join(cells, columnGap)
+}
+
+// align pads cell with spaces to w columns according to a
+func align(cell string, w int, a Alignment) string {
+	switch a {
+	case AlignRight:
+		return text.PadLeft(cell, w)
+	case AlignCenter:
+		return text.CenterText(cell, w)
+	default:
+		return text.PadRight(cell, w)
+	}
+}
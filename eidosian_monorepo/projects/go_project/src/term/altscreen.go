@@ -0,0 +1,69 @@
+package term
+
+import (
+	"io"
+	"os"
+)
+
+// Escape sequences switching to and from the alternate screen buffer
+const (
+	enterAltScreenSeq = "\x1b[?1049h"
+	leaveAltScreenSeq = "\x1b[?1049l"
+)
+
+// EnterAltScreen switches stdout to the alternate screen buffer, saving the
+// original screen. It does nothing unless stdout is a terminal that supports
+// cursor control.
+func EnterAltScreen() error {
+	if !DetectCapabilities(os.Stdout).Cursor {
+		return nil
+	}
+	return EnterAltScreenTo(os.Stdout)
+}
+
+// LeaveAltScreen restores the screen saved by EnterAltScreen. Like
+// EnterAltScreen it does nothing without cursor control.
+func LeaveAltScreen() error {
+	if !DetectCapabilities(os.Stdout).Cursor {
+		return nil
+	}
+	return LeaveAltScreenTo(os.Stdout)
+}
+
+// WithAltScreen runs fn on the alternate screen buffer of stdout, or on the
+// normal screen when stdout lacks cursor control
+func WithAltScreen(fn func()) error {
+	if !DetectCapabilities(os.Stdout).Cursor {
+		fn()
+		return nil
+	}
+	return WithAltScreenTo(os.Stdout, fn)
+}
+
+// EnterAltScreenTo writes the sequence entering the alternate screen to w.
+// Like RestoreTerminal it writes unconditionally.
+func EnterAltScreenTo(w io.Writer) error {
+	_, err := io.WriteString(w, enterAltScreenSeq)
+	return err
+}
+
+// LeaveAltScreenTo writes the sequence leaving the alternate screen to w
+func LeaveAltScreenTo(w io.Writer) error {
+	_, err := io.WriteString(w, leaveAltScreenSeq)
+	return err
+}
+
+// WithAltScreenTo enters the alternate screen on w, runs fn and leaves it
+// again. The screen is restored even if fn panics; the panic then carries on.
+func WithAltScreenTo(w io.Writer, fn func()) (err error) {
+	if err := EnterAltScreenTo(w); err != nil {
+		return err
+	}
+	defer func() {
+		if leaveErr := LeaveAltScreenTo(w); err == nil {
+			err = leaveErr
+		}
+	}()
+	fn()
+	return nil
+}
@@ -0,0 +1,61 @@
+package term
+
+import (
+	"io"
+	"os"
+	"strings"
+)
+
+// Capabilities describes what a writer's terminal can do. Rendering helpers
+// consult it rather than checking the terminal themselves, so a dumb
+// terminal degrades every widget to plain text in the same way.
+type Capabilities struct {
+	// Color allows SGR sequences for colors and text attributes
+	Color bool
+	// Cursor allows cursor movement, line clearing and carriage-return
+	// redraws
+	Cursor bool
+	// Hyperlinks allows OSC 8 links
+	Hyperlinks bool
+	// Emoji allows symbols and emoji beyond ASCII
+	Emoji bool
+}
+
+// IsDumbTerminal reports whether $TERM is "dumb" or unset, meaning the
+// terminal interprets no escape sequences
+func IsDumbTerminal() bool {
+	t := os.Getenv("TERM")
+	return t == "" || t == "dumb"
+}
+
+// DetectCapabilities reports the capabilities of w. Writers that are not
+// terminals, and dumb terminals, have none.
+func DetectCapabilities(w io.Writer) Capabilities {
+	return capabilitiesFor(IsTerminalWriter(w))
+}
+
+// capabilitiesFor reports the capabilities of a writer, given whether it is
+// a terminal, from the environment describing that terminal
+func capabilitiesFor(isTTY bool) Capabilities {
+	if !isTTY || IsDumbTerminal() {
+		return Capabilities{}
+	}
+	return Capabilities{
+		Color:      true,
+		Cursor:     true,
+		Hyperlinks: hyperlinkProgram(),
+		Emoji:      utf8Locale(),
+	}
+}
+
+// utf8Locale reports whether the locale selected by $LC_ALL, $LC_CTYPE or
+// $LANG, in that order of precedence, uses UTF-8
+func utf8Locale() bool {
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := os.Getenv(name); v != "" {
+			v = strings.ToLower(v)
+			return strings.Contains(v, "utf-8") || strings.Contains(v, "utf8")
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package term
+
+import "testing"
+
+func TestCapabilitiesForDumbTerminal(t *testing.T) {
+	t.Setenv("TERM_PROGRAM", "iTerm.app")
+	t.Setenv("LANG", "en_US.UTF-8")
+	for _, value := range []string{"dumb", ""} {
+		t.Setenv("TERM", value)
+		if caps := capabilitiesFor(true); caps != (Capabilities{}) {
+			t.Errorf("capabilitiesFor(true) with TERM=%q = %+v, want none", value, caps)
+		}
+	}
+}
+
+func TestCapabilitiesForTerminal(t *testing.T) {
+	t.Setenv("TERM", "xterm-256color")
+	t.Setenv("TERM_PROGRAM", "iTerm.app")
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_CTYPE", "")
+	t.Setenv("LANG", "en_US.UTF-8")
+	want := Capabilities{Color: true, Cursor: true, Hyperlinks: true, Emoji: true}
+	if caps := capabilitiesFor(true); caps != want {
+		t.Errorf("capabilitiesFor(true) = %+v, want %+v", caps, want)
+	}
+	if caps := capabilitiesFor(false); caps != (Capabilities{}) {
+		t.Errorf("capabilitiesFor(false) = %+v, want none", caps)
+	}
+}
@@ -0,0 +1,71 @@
+package term
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Confirm asks a yes/no question on stderr and reads the answer from stdin.
+// An empty answer takes def. When stdin is not a terminal nobody can answer,
+// so def is returned without prompting or blocking.
+func Confirm(prompt string, def bool) (bool, error) {
+	if !IsTerminal(os.Stdin) {
+		return def, nil
+	}
+	return ConfirmFrom(os.Stdin, os.Stderr, prompt, def)
+}
+
+// ConfirmFrom asks prompt on out, with a [Y/n] or [y/N] hint showing def, and
+// reads answers from in until one is y, yes, n or no in any case. An empty
+// answer or end of input takes def.
+func ConfirmFrom(in io.Reader, out io.Writer, prompt string, def bool) (bool, error) {
+	hint := "[y/N]"
+	if def {
+		hint = "[Y/n]"
+	}
+	for {
+		if _, err := fmt.Fprintf(out, "%s %s ", prompt, hint); err != nil {
+			return def, err
+		}
+		line, err := readLine(in)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return def, err
+		}
+		switch strings.ToLower(strings.TrimSpace(line)) {
+		case "":
+			return def, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		if errors.Is(err, io.EOF) {
+			return def, nil
+		}
+		if _, err := fmt.Fprintln(out, "Please answer y or n."); err != nil {
+			return def, err
+		}
+	}
+}
+
+// readLine reads up to and excluding the next newline. It reads a byte at a
+// time so that nothing past the line is consumed from in.
+func readLine(in io.Reader) (string, error) {
+	var b strings.Builder
+	buf := make([]byte, 1)
+	for {
+		n, err := in.Read(buf)
+		if n > 0 {
+			if buf[0] == '\n' {
+				return b.String(), nil
+			}
+			b.WriteByte(buf[0])
+		}
+		if err != nil {
+			return b.String(), err
+		}
+	}
+}
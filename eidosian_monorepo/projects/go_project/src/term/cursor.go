@@ -0,0 +1,105 @@
+package term
+
+import (
+	"io"
+	"strconv"
+)
+
+// csi introduces a control sequence
+const csi = "\x1b["
+
+// MoveUp returns the sequence moving the cursor up n lines, or nothing when
+// n is not positive
+func MoveUp(n int) string {
+	return move(n, 'A')
+}
+
+// MoveDown returns the sequence moving the cursor down n lines, or nothing
+// when n is not positive
+func MoveDown(n int) string {
+	return move(n, 'B')
+}
+
+// ClearLine returns the sequence erasing the whole current line and
+// returning the cursor to its first column
+func ClearLine() string {
+	return "\r" + csi + "2K"
+}
+
+// ClearToEnd returns the sequence erasing from the cursor to the end of the
+// line
+func ClearToEnd() string {
+	return csi + "K"
+}
+
+// HideCursor returns the sequence hiding the cursor
+func HideCursor() string {
+	return csi + "?25l"
+}
+
+// ShowCursor returns the sequence showing the cursor
+func ShowCursor() string {
+	return csi + "?25h"
+}
+
+// move returns the cursor movement sequence ending in final for n cells
+func move(n int, final byte) string {
+	if n <= 0 {
+		return ""
+	}
+	return csi + strconv.Itoa(n) + string(final)
+}
+
+// Cursor writes cursor control sequences to a writer, doing nothing unless
+// the writer is a terminal with cursor control
+type Cursor struct {
+	w io.Writer
+
+	// Enabled controls whether sequences are written; NewCursor sets it from
+	// the capabilities of the writer
+	Enabled bool
+}
+
+// NewCursor creates a Cursor writing to w
+func NewCursor(w io.Writer) *Cursor {
+	return &Cursor{w: w, Enabled: DetectCapabilities(w).Cursor}
+}
+
+// MoveUp moves the cursor up n lines
+func (c *Cursor) MoveUp(n int) error {
+	return c.write(MoveUp(n))
+}
+
+// MoveDown moves the cursor down n lines
+func (c *Cursor) MoveDown(n int) error {
+	return c.write(MoveDown(n))
+}
+
+// ClearLine erases the current line and returns to its first column
+func (c *Cursor) ClearLine() error {
+	return c.write(ClearLine())
+}
+
+// ClearToEnd erases from the cursor to the end of the line
+func (c *Cursor) ClearToEnd() error {
+	return c.write(ClearToEnd())
+}
+
+// HideCursor hides the cursor
+func (c *Cursor) HideCursor() error {
+	return c.write(HideCursor())
+}
+
+// ShowCursor shows the cursor
+func (c *Cursor) ShowCursor() error {
+	return c.write(ShowCursor())
+}
+
+// write sends seq to the writer when enabled
+func (c *Cursor) write(seq string) error {
+	if !c.Enabled || seq == "" {
+		return nil
+	}
+	_, err := io.WriteString(c.w, seq)
+	return err
+}
@@ -0,0 +1,81 @@
+package term
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"go_project/src/internal/width"
+)
+
+// EnvForceHyperlinks names the environment variable that overrides hyperlink
+// detection: a true value always emits OSC 8 links, a false value never does
+const EnvForceHyperlinks = "FORGE_FORCE_HYPERLINKS"
+
+// hyperlinkPrograms are $TERM_PROGRAM values of terminals known to render
+// OSC 8 hyperlinks
+var hyperlinkPrograms = []string{"iTerm.app", "WezTerm", "vscode", "Hyper", "ghostty", "Tabby"}
+
+// OSC 8 framing; the link is closed by repeating the opener with no URL
+const (
+	osc8Open = "\x1b]8;;"
+	osc8End  = "\x1b\\"
+	osc8Pref = "\x1b]8;"
+)
+
+// HyperlinksSupported reports whether stdout is a terminal that is expected
+// to render OSC 8 hyperlinks, honouring FORGE_FORCE_HYPERLINKS
+func HyperlinksSupported() bool {
+	if force, err := strconv.ParseBool(os.Getenv(EnvForceHyperlinks)); err == nil {
+		return force
+	}
+	return DetectCapabilities(os.Stdout).Hyperlinks
+}
+
+// hyperlinkProgram reports whether $TERM_PROGRAM names a terminal known to
+// render OSC 8 hyperlinks
+func hyperlinkProgram() bool {
+	program := os.Getenv("TERM_PROGRAM")
+	for _, p := range hyperlinkPrograms {
+		if program == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Hyperlink makes text a clickable link to url where supported, and renders
+// it as "text (url)" elsewhere. An empty url, or one containing control
+// characters that would break the escape sequence, returns text unchanged.
+// Links already inside text are removed since OSC 8 links cannot nest.
+func Hyperlink(text, url string) string {
+	if url == "" || strings.ContainsFunc(url, isControl) {
+		return text
+	}
+	if !HyperlinksSupported() {
+		return text + " (" + url + ")"
+	}
+	return osc8Open + url + osc8End + stripHyperlinks(text) + osc8Open + osc8End
+}
+
+// isControl reports whether r is a C0 control character or DEL
+func isControl(r rune) bool {
+	return r < 0x20 || r == 0x7f
+}
+
+// stripHyperlinks removes OSC 8 sequences from s, keeping their link text
+func stripHyperlinks(s string) string {
+	if !strings.Contains(s, osc8Pref) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if strings.HasPrefix(s[i:], osc8Pref) {
+			i += width.EscapeLen(s[i:])
+			continue
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+	return b.String()
+}
@@ -0,0 +1,31 @@
+package term
+
+import "sync"
+
+var (
+	resizeMu        sync.Mutex
+	resizeCallbacks []func(cols, rows int)
+	resizeOnce      sync.Once
+)
+
+// OnResize registers fn to be called with the new terminal size whenever the
+// window is resized. Callbacks run on a single background goroutine, in the
+// order they were registered. On platforms without resize notifications fn
+// is never called.
+func OnResize(fn func(cols, rows int)) {
+	resizeMu.Lock()
+	resizeCallbacks = append(resizeCallbacks, fn)
+	resizeMu.Unlock()
+	resizeOnce.Do(func() { watchResize(notifyResize) })
+}
+
+// notifyResize passes the current size to every registered callback
+func notifyResize() {
+	cols, rows, _ := TerminalSize()
+	resizeMu.Lock()
+	callbacks := append([]func(int, int){}, resizeCallbacks...)
+	resizeMu.Unlock()
+	for _, fn := range callbacks {
+		fn(cols, rows)
+	}
+}
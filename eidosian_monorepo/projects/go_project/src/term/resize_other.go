@@ -0,0 +1,6 @@
+//go:build !linux && !darwin && !dragonfly && !freebsd && !netbsd && !openbsd
+
+package term
+
+// watchResize does nothing on platforms without SIGWINCH
+func watchResize(notify func()) {}
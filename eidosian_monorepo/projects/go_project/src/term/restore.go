@@ -0,0 +1,11 @@
+package term
+
+import "io"
+
+// RestoreTerminal clears the current line and shows the cursor, undoing the
+// state an interrupted widget may leave behind. It writes unconditionally;
+// callers should only pass writers that are terminals.
+func RestoreTerminal(w io.Writer) error {
+	_, err := io.WriteString(w, ClearLine()+ShowCursor())
+	return err
+}
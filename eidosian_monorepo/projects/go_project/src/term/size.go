@@ -0,0 +1,46 @@
+package term
+
+import (
+	"errors"
+	"os"
+	"strconv"
+)
+
+// Fallback dimensions used when neither the terminal nor the environment
+// reports a size
+const (
+	DefaultWidth  = 80
+	DefaultHeight = 24
+)
+
+// errNoSize is returned on platforms that cannot query the window size
+var errNoSize = errors.New("terminal size unavailable")
+
+// TerminalSize reports the size of the terminal attached to stdout. Any
+// dimension the terminal does not report is taken from $COLUMNS or $LINES,
+// then from DefaultWidth and DefaultHeight, so cols and rows are always
+// usable; err reports why the terminal itself could not be queried.
+func TerminalSize() (cols, rows int, err error) {
+	cols, rows, err = windowSize(os.Stdout.Fd())
+	if cols <= 0 {
+		cols = envSize("COLUMNS", DefaultWidth)
+	}
+	if rows <= 0 {
+		rows = envSize("LINES", DefaultHeight)
+	}
+	return cols, rows, err
+}
+
+// Width reports the number of columns TerminalSize finds
+func Width() int {
+	cols, _, _ := TerminalSize()
+	return cols
+}
+
+// envSize parses a positive size from the environment variable key
+func envSize(key string, def int) int {
+	if n, err := strconv.Atoi(os.Getenv(key)); err == nil && n > 0 {
+		return n
+	}
+	return def
+}
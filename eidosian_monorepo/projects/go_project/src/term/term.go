@@ -0,0 +1,23 @@
+// Package term inspects and controls the terminal the process is attached to
+package term
+
+import (
+	"io"
+	"os"
+)
+
+// IsTerminal reports whether f refers to an interactive terminal. A nil file
+// or one whose descriptor cannot be queried is treated as not a terminal.
+func IsTerminal(f *os.File) bool {
+	if f == nil {
+		return false
+	}
+	return isTerminal(f.Fd())
+}
+
+// IsTerminalWriter reports whether w is a terminal. Writers that are not
+// backed by an *os.File, such as buffers substituted in tests, never are.
+func IsTerminalWriter(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && IsTerminal(f)
+}
@@ -0,0 +1,13 @@
+//go:build !linux && !darwin && !dragonfly && !freebsd && !netbsd && !openbsd && !windows
+
+package term
+
+// isTerminal always reports false on platforms without terminal detection
+func isTerminal(fd uintptr) bool {
+	return false
+}
+
+// windowSize always fails on platforms without terminal detection
+func windowSize(fd uintptr) (cols, rows int, err error) {
+	return 0, 0, errNoSize
+}
@@ -0,0 +1,15 @@
+package term
+
+import "syscall"
+
+// isTerminal asks the console API for the mode of fd; only consoles have one
+func isTerminal(fd uintptr) bool {
+	var mode uint32
+	return syscall.GetConsoleMode(syscall.Handle(fd), &mode) == nil
+}
+
+// windowSize is not implemented for consoles; callers fall back to the
+// environment
+func windowSize(fd uintptr) (cols, rows int, err error) {
+	return 0, 0, errNoSize
+}
@@ -0,0 +1,59 @@
+package text
+
+import (
+	"strings"
+
+	"go_project/src/term"
+)
+
+// keySeparator follows each key in a definition list
+const keySeparator = ": "
+
+// DefinitionList renders "key: value" pairs with the keys padded so that
+// every colon falls in the same column. The zero value is an empty list.
+type DefinitionList struct {
+	keys   []string
+	values []string
+
+	// Width is the total width values wrap within; zero uses the terminal
+	// width
+	Width int
+}
+
+// Add appends a pair. A value containing newlines renders as several lines
+// under the value column. An empty key renders no colon, so its value reads
+// as a continuation of the previous entry.
+func (d *DefinitionList) Add(key, value string) {
+	d.keys = append(d.keys, key)
+	d.values = append(d.values, value)
+}
+
+// Render lays out the list, one or more lines per pair, without a trailing
+// newline
+func (d *DefinitionList) Render() string {
+	keyWidth := 0
+	for _, k := range d.keys {
+		keyWidth = max(keyWidth, DisplayWidth(k))
+	}
+	total := d.Width
+	if total <= 0 {
+		total = term.Width()
+	}
+	indent := strings.Repeat(" ", keyWidth+len(keySeparator))
+	valueWidth := max(total-len(indent), 1)
+
+	var lines []string
+	for i, key := range d.keys {
+		prefix := indent
+		if key != "" {
+			prefix = key + strings.Repeat(" ", keyWidth-DisplayWidth(key)) + keySeparator
+		}
+		for j, line := range WrapText(d.values[i], valueWidth) {
+			if j > 0 {
+				prefix = indent
+			}
+			lines = append(lines, strings.TrimRight(prefix+line, " "))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,76 @@
+package text
+
+import (
+	"bytes"
+	"io"
+)
+
+// IndentWriter prefixes every line written through it with the indent unit
+// repeated once per nesting level. Partial lines are buffered until their
+// newline arrives, so a line split across several writes is indented once,
+// at the depth in effect when it completes. Blank lines are not indented.
+type IndentWriter struct {
+	w     io.Writer
+	unit  []byte
+	depth int
+	buf   []byte
+}
+
+// NewIndentWriter creates a writer indenting with unit, e.g. "  " or "\t",
+// starting at depth zero
+func NewIndentWriter(w io.Writer, unit string) *IndentWriter {
+	return &IndentWriter{w: w, unit: []byte(unit)}
+}
+
+// Push increases the nesting depth by one
+func (iw *IndentWriter) Push() {
+	iw.depth++
+}
+
+// Pop decreases the nesting depth by one, stopping at zero
+func (iw *IndentWriter) Pop() {
+	if iw.depth > 0 {
+		iw.depth--
+	}
+}
+
+// Depth returns the current nesting depth
+func (iw *IndentWriter) Depth() int {
+	return iw.depth
+}
+
+// Write indents and forwards every complete line in p, holding back any
+// trailing partial line until a later Write or Flush
+func (iw *IndentWriter) Write(p []byte) (int, error) {
+	iw.buf = append(iw.buf, p...)
+	for {
+		i := bytes.IndexByte(iw.buf, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		if err := iw.writeLine(iw.buf[:i+1]); err != nil {
+			return 0, err
+		}
+		iw.buf = iw.buf[i+1:]
+	}
+}
+
+// Flush writes any buffered partial line, indented and without a newline
+func (iw *IndentWriter) Flush() error {
+	if len(iw.buf) == 0 {
+		return nil
+	}
+	err := iw.writeLine(iw.buf)
+	iw.buf = iw.buf[:0]
+	return err
+}
+
+// writeLine writes one line with its indentation
+func (iw *IndentWriter) writeLine(line []byte) error {
+	var out []byte
+	if len(line) > 0 && line[0] != '\n' {
+		out = bytes.Repeat(iw.unit, iw.depth)
+	}
+	_, err := iw.w.Write(append(out, line...))
+	return err
+}
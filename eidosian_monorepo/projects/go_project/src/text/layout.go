@@ -0,0 +1,52 @@
+package text
+
+import "strings"
+
+// HStack joins two multi-line blocks side by side. Each block is padded to
+// its widest line, and the shorter one with blank lines, so that every row
+// of the result has the same width.
+func HStack(left, right string) string {
+	l, lw := block(left)
+	r, rw := block(right)
+	rows := max(len(l), len(r))
+	lines := make([]string, rows)
+	for i := range lines {
+		lines[i] = PadRight(lineAt(l, i), lw) + PadRight(lineAt(r, i), rw)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// VStack places top above bottom, padding every line to the width of the
+// widest so that the result is a rectangular block
+func VStack(top, bottom string) string {
+	t, tw := block(top)
+	b, bw := block(bottom)
+	w := max(tw, bw)
+	lines := make([]string, 0, len(t)+len(b))
+	for _, line := range append(t, b...) {
+		lines = append(lines, PadRight(line, w))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// block splits s into lines and reports the width of the widest. An empty
+// string has no lines.
+func block(s string) ([]string, int) {
+	if s == "" {
+		return nil, 0
+	}
+	lines := strings.Split(s, "\n")
+	w := 0
+	for _, line := range lines {
+		w = max(w, DisplayWidth(line))
+	}
+	return lines, w
+}
+
+// lineAt returns line i of lines, or an empty string past the end
+func lineAt(lines []string, i int) string {
+	if i < len(lines) {
+		return lines[i]
+	}
+	return ""
+}
@@ -0,0 +1,22 @@
+package text
+
+import "strings"
+
+// linkPrefix starts an OSC 8 hyperlink sequence and linkClose ends the link
+// it opened
+const (
+	linkPrefix = "\x1b]8;"
+	linkClose  = "\x1b]8;;\x1b\\"
+)
+
+// hyperlink reports whether seq is an OSC 8 sequence and, if it is, whether
+// it opens a link rather than closing one
+func hyperlink(seq string) (isLink, opens bool) {
+	rest, ok := strings.CutPrefix(seq, linkPrefix)
+	if !ok {
+		return false, false
+	}
+	rest = strings.TrimSuffix(strings.TrimSuffix(rest, "\x1b\\"), "\x07")
+	_, url, _ := strings.Cut(rest, ";")
+	return true, url != ""
+}
@@ -0,0 +1,71 @@
+package text
+
+import (
+	"strings"
+
+	"go_project/src/color"
+)
+
+// Markdown rendering constants
+const (
+	bulletMarker = "- "
+	bulletGlyph  = "• "
+	boldMarker   = "**"
+	codeMarker   = "`"
+	// codeColor highlights inline code spans
+	codeColor = color.Cyan
+)
+
+// RenderMarkdown converts the small subset of markdown used in result
+// messages for terminal display: **bold** and `code` spans become ANSI
+// styles, and "- " bullet lines become indented bullets, nested by two
+// leading spaces per level. When color is disabled the markers are removed
+// and the text is left plain. Anything else, including unmatched markers, is
+// kept as written.
+func RenderMarkdown(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = renderMarkdownLine(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderMarkdownLine renders one line, including any bullet prefix
+func renderMarkdownLine(line string) string {
+	trimmed := strings.TrimLeft(line, " ")
+	if item, ok := strings.CutPrefix(trimmed, bulletMarker); ok {
+		depth := (len(line) - len(trimmed)) / 2
+		return strings.Repeat("  ", depth+1) + bulletGlyph + renderInline(item)
+	}
+	return renderInline(line)
+}
+
+// renderInline styles the bold and code spans of s
+func renderInline(s string) string {
+	var b strings.Builder
+	for s != "" {
+		switch {
+		case strings.HasPrefix(s, boldMarker):
+			end := strings.Index(s[len(boldMarker):], boldMarker)
+			if end <= 0 {
+				break
+			}
+			inner := s[len(boldMarker) : len(boldMarker)+end]
+			b.WriteString(color.Style{Attrs: color.Bold}.Apply(renderInline(inner)))
+			s = s[2*len(boldMarker)+end:]
+			continue
+		case strings.HasPrefix(s, codeMarker):
+			end := strings.Index(s[len(codeMarker):], codeMarker)
+			if end <= 0 {
+				break
+			}
+			inner := s[len(codeMarker) : len(codeMarker)+end]
+			b.WriteString(color.Colorize(inner, codeColor, color.Default))
+			s = s[2*len(codeMarker)+end:]
+			continue
+		}
+		b.WriteByte(s[0])
+		s = s[1:]
+	}
+	return b.String()
+}
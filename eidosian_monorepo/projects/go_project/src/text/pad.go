@@ -0,0 +1,31 @@
+package text
+
+import "strings"
+
+// PadRight appends spaces to s until it is width columns wide
+func PadRight(s string, width int) string {
+	return s + spaces(width-DisplayWidth(s))
+}
+
+// PadLeft prepends spaces to s until it is width columns wide
+func PadLeft(s string, width int) string {
+	return spaces(width-DisplayWidth(s)) + s
+}
+
+// CenterText surrounds s with spaces to center it in width columns. When the
+// space cannot be split evenly the extra column goes on the right.
+func CenterText(s string, width int) string {
+	gap := width - DisplayWidth(s)
+	if gap <= 0 {
+		return s
+	}
+	return spaces(gap/2) + s + spaces(gap-gap/2)
+}
+
+// spaces returns n spaces, or nothing when n is not positive
+func spaces(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return strings.Repeat(" ", n)
+}
@@ -0,0 +1,31 @@
+package text
+
+import (
+	"strings"
+
+	"go_project/src/internal/width"
+)
+
+// StripANSI removes CSI, OSC and other escape sequences from s, leaving only
+// the visible text. OSC sequences such as hyperlinks may end with either BEL
+// or ST.
+func StripANSI(s string) string {
+	if !strings.Contains(s, "\x1b") {
+		return s
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		if n := width.EscapeLen(s[i:]); n > 0 {
+			i += n
+			continue
+		}
+		j := i + 1
+		for j < len(s) && s[j] != 0x1b {
+			j++
+		}
+		b.WriteString(s[i:j])
+		i = j
+	}
+	return b.String()
+}
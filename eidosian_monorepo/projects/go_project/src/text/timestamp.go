@@ -0,0 +1,60 @@
+package text
+
+import (
+	"bytes"
+	"io"
+	"time"
+)
+
+// TimestampWriter prefixes every line written through it with the current
+// time in RFC 3339 format. As with IndentWriter, partial lines are buffered
+// until their newline arrives, so each line is stamped exactly once, with
+// the time at which it completed.
+type TimestampWriter struct {
+	w   io.Writer
+	now func() time.Time
+	buf []byte
+}
+
+// NewTimestampWriter creates a writer stamping lines with the time now
+// reports; nil uses time.Now
+func NewTimestampWriter(w io.Writer, now func() time.Time) *TimestampWriter {
+	if now == nil {
+		now = time.Now
+	}
+	return &TimestampWriter{w: w, now: now}
+}
+
+// Write stamps and forwards every complete line in p, holding back any
+// trailing partial line until a later Write or Flush
+func (tw *TimestampWriter) Write(p []byte) (int, error) {
+	tw.buf = append(tw.buf, p...)
+	for {
+		i := bytes.IndexByte(tw.buf, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		if err := tw.writeLine(tw.buf[:i+1]); err != nil {
+			return 0, err
+		}
+		tw.buf = tw.buf[i+1:]
+	}
+}
+
+// Flush writes any buffered partial line, stamped and without a newline
+func (tw *TimestampWriter) Flush() error {
+	if len(tw.buf) == 0 {
+		return nil
+	}
+	err := tw.writeLine(tw.buf)
+	tw.buf = tw.buf[:0]
+	return err
+}
+
+// writeLine writes one line after its timestamp
+func (tw *TimestampWriter) writeLine(line []byte) error {
+	out := tw.now().AppendFormat(nil, time.RFC3339)
+	out = append(out, ' ')
+	_, err := tw.w.Write(append(out, line...))
+	return err
+}
@@ -0,0 +1,52 @@
+package text
+
+import (
+	"strings"
+
+	"go_project/src/internal/width"
+)
+
+// Ellipsis marks where Truncate cut text short
+const Ellipsis = "…"
+
+// Truncate shortens s to at most w columns, ending it with Ellipsis when
+// anything was cut. It never splits a character cluster or an escape
+// sequence, and adds a reset when an SGR sequence was kept so colors do not
+// leak past the cut. A hyperlink still open at the cut is closed likewise.
+func Truncate(s string, w int) string {
+	if DisplayWidth(s) <= w {
+		return s
+	}
+	if w <= 0 {
+		return ""
+	}
+	budget := w - DisplayWidth(Ellipsis)
+	var b strings.Builder
+	col, styled, linked := 0, false, false
+	for i := 0; i < len(s); {
+		if e := width.EscapeLen(s[i:]); e > 0 {
+			b.WriteString(s[i : i+e])
+			styled = styled || strings.HasPrefix(s[i:], "\x1b[")
+			if isLink, opens := hyperlink(s[i : i+e]); isLink {
+				linked = opens
+			}
+			i += e
+			continue
+		}
+		size, cw := width.Cluster(s[i:], col)
+		if col+cw > budget {
+			break
+		}
+		b.WriteString(s[i : i+size])
+		col += cw
+		i += size
+	}
+	b.WriteString(Ellipsis)
+	if styled {
+		b.WriteString(reset)
+	}
+	if linked {
+		b.WriteString(linkClose)
+	}
+	return b.String()
+}
@@ -0,0 +1,10 @@
+package text
+
+import "go_project/src/internal/width"
+
+// DisplayWidth reports the number of terminal columns s occupies. East Asian
+// wide characters and emoji count as two columns; combining marks and escape
+// sequences as none. Tabs advance to the next multiple of eight columns.
+func DisplayWidth(s string) int {
+	return width.String(s)
+}
@@ -0,0 +1,117 @@
+// Package text measures and lays out terminal text, treating ANSI escape
+// sequences as zero-width
+package text
+
+import (
+	"strings"
+	"unicode"
+
+	"go_project/src/internal/width"
+)
+
+// reset clears all SGR attributes
+const reset = "\x1b[0m"
+
+// WrapText breaks s into lines no wider than width columns. Lines break on
+// spaces where possible and words wider than width are split between
+// character clusters.
+// Embedded newlines always start a new line. Escape sequences are kept
+// intact; colors and hyperlinks that are active across a break are ended at
+// the end of the line and reapplied at the start of the next one.
+func WrapText(s string, width int) []string {
+	w := wrapper{width: max(width, 1)}
+	for i, para := range strings.Split(s, "\n") {
+		if i > 0 {
+			w.breakLine()
+		}
+		for _, word := range strings.FieldsFunc(para, unicode.IsSpace) {
+			w.addWord(word)
+		}
+	}
+	w.breakLine()
+	return w.lines
+}
+
+// wrapper accumulates wrapped lines
+type wrapper struct {
+	width  int
+	lines  []string
+	line   strings.Builder
+	col    int
+	active string
+	// link is the OSC 8 sequence that opened the current hyperlink, if any
+	link string
+}
+
+// addWord places word on the current line, moving to a new line when it
+// does not fit
+func (w *wrapper) addWord(word string) {
+	ww := DisplayWidth(word)
+	switch {
+	case ww == 0:
+		// Bare escape sequences attach to whatever follows them
+		w.write(word)
+		return
+	case w.col > 0 && w.col+1+ww <= w.width:
+		w.line.WriteByte(' ')
+		w.col++
+	case w.col > 0:
+		w.breakLine()
+	}
+	w.write(word)
+}
+
+// write appends s to the current line, hard-breaking it between character
+// clusters when it would overflow
+func (w *wrapper) write(s string) {
+	for i := 0; i < len(s); {
+		if e := width.EscapeLen(s[i:]); e > 0 {
+			w.escape(s[i : i+e])
+			i += e
+			continue
+		}
+		size, cw := width.Cluster(s[i:], w.col)
+		if w.col > 0 && w.col+cw > w.width {
+			w.breakLine()
+		}
+		w.line.WriteString(s[i : i+size])
+		w.col += cw
+		i += size
+	}
+}
+
+// escape appends an escape sequence, tracking which SGR attributes and
+// hyperlink are active
+func (w *wrapper) escape(seq string) {
+	w.line.WriteString(seq)
+	if isLink, opens := hyperlink(seq); isLink {
+		w.link = ""
+		if opens {
+			w.link = seq
+		}
+		return
+	}
+	if !strings.HasPrefix(seq, "\x1b[") || !strings.HasSuffix(seq, "m") {
+		return
+	}
+	if seq == reset || seq == "\x1b[m" {
+		w.active = ""
+	} else {
+		w.active += seq
+	}
+}
+
+// breakLine finishes the current line and starts the next with the active
+// attributes and hyperlink restored
+func (w *wrapper) breakLine() {
+	if w.active != "" {
+		w.line.WriteString(reset)
+	}
+	if w.link != "" {
+		w.line.WriteString(linkClose)
+	}
+	w.lines = append(w.lines, w.line.String())
+	w.line.Reset()
+	w.line.WriteString(w.active + w.link)
+	w.col = 0
+}
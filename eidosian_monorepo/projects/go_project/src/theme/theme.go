@@ -0,0 +1,112 @@
+// Package theme assigns colors to the roles used across forge output
+package theme
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"go_project/src/color"
+	"go_project/src/term"
+)
+
+// Theme holds the color used for each output role
+type Theme struct {
+	SuccessColor color.Color `json:"success"`
+	ErrorColor   color.Color `json:"error"`
+	WarnColor    color.Color `json:"warn"`
+	InfoColor    color.Color `json:"info"`
+	BorderColor  color.Color `json:"border"`
+	// AccentColor highlights labels such as box titles
+	AccentColor color.Color `json:"accent"`
+}
+
+// DefaultTheme returns the colors forge uses when no theme is configured
+func DefaultTheme() *Theme {
+	return &Theme{
+		SuccessColor: color.Green,
+		ErrorColor:   color.Red,
+		WarnColor:    color.Yellow,
+		InfoColor:    color.Blue,
+		BorderColor:  color.BrightBlack,
+		AccentColor:  color.Default,
+	}
+}
+
+// LoadTheme reads a theme from a JSON file mapping roles to color names, e.g.
+// {"success": "bright-green", "border": "cyan"}. Roles left out keep their
+// default color; unknown roles and color names are rejected.
+func LoadTheme(path string) (*Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("load theme: %w", err)
+	}
+	t := DefaultTheme()
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(t); err != nil {
+		return nil, fmt.Errorf("load theme %s: %w", path, err)
+	}
+	return t, nil
+}
+
+// OrDefault returns t, or the default theme when t is nil
+func (t *Theme) OrDefault() *Theme {
+	if t == nil {
+		return DefaultTheme()
+	}
+	return t
+}
+
+// StatusColor returns the color for a result status
+func (t *Theme) StatusColor(status string) color.Color {
+	switch status {
+	case "success":
+		return t.SuccessColor
+	case "error":
+		return t.ErrorColor
+	case "warning":
+		return t.WarnColor
+	default:
+		return t.InfoColor
+	}
+}
+
+// Status icons and the ASCII markers drawn in their place where symbols
+// cannot be rendered
+var (
+	statusIcons = map[string]string{"success": "✓", "error": "✗", "warning": "⚠"}
+	asciiIcons  = map[string]string{"success": "[OK]", "error": "[ERR]", "warning": "[WARN]"}
+)
+
+// Icons draws status icons in the colors of a theme
+type Icons struct {
+	// Theme colors the icons; nil uses the default theme
+	Theme *Theme
+	// ASCII draws markers such as [OK] instead of symbols
+	ASCII bool
+}
+
+// IconsFor returns the icons to draw on a terminal with caps, which are
+// ASCII markers unless it can render symbols
+func IconsFor(th *Theme, caps term.Capabilities) Icons {
+	return Icons{Theme: th, ASCII: !caps.Emoji}
+}
+
+// StatusIcon returns a marker for a result status in the status color: a
+// symbol such as ✓, or an ASCII marker such as [OK]. Unknown statuses are
+// marked as information.
+func (i Icons) StatusIcon(status string) string {
+	icon, ok := statusIcons[status]
+	if !ok {
+		icon = "ℹ"
+	}
+	if i.ASCII {
+		icon, ok = asciiIcons[status]
+		if !ok {
+			icon = "[INFO]"
+		}
+	}
+	return color.Colorize(icon, i.Theme.OrDefault().StatusColor(status), color.Default)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Build information, set at link time with e.g.
+// go build -ldflags "-X main.Version=1.2.0 -X main.Commit=abc123 -X main.BuildDate=2024-05-01"
+var (
+	Version   string
+	Commit    string
+	BuildDate string
+)
+
+// unknownBuild is reported for build information that was not set
+const unknownBuild = "dev"
+
+// versionInfo is the build information printed by --version
+type versionInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+}
+
+// currentVersion returns the build information, substituting unknownBuild
+// for anything left unset
+func currentVersion() versionInfo {
+	orDev := func(s string) string {
+		if s == "" {
+			return unknownBuild
+		}
+		return s
+	}
+	return versionInfo{
+		Version:   orDev(Version),
+		Commit:    orDev(Commit),
+		BuildDate: orDev(BuildDate),
+	}
+}
+
+// printVersion writes the build information to w, as a readable line when
+// human is set and as JSON otherwise
+func printVersion(w io.Writer, human bool) error {
+	info := currentVersion()
+	if human {
+		_, err := fmt.Fprintf(w, "forge %s (commit %s, built %s)\n", info.Version, info.Commit, info.BuildDate)
+		return err
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
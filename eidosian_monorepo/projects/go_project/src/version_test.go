@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPrintVersionFallsBackToDev(t *testing.T) {
+	var buf bytes.Buffer
+	if err := printVersion(&buf, false); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"version":"dev","commit":"dev","build_date":"dev"}` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("printVersion() = %q, want %q", got, want)
+	}
+
+	buf.Reset()
+	if err := printVersion(&buf, true); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "forge dev (commit dev, built dev)\n"; got != want {
+		t.Errorf("printVersion(human) = %q, want %q", got, want)
+	}
+}
+
+func TestPrintVersionUsesBuildInfo(t *testing.T) {
+	defer func(v, c, d string) { Version, Commit, BuildDate = v, c, d }(Version, Commit, BuildDate)
+	Version, Commit = "1.2.0", "abc123"
+
+	var buf bytes.Buffer
+	if err := printVersion(&buf, true); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "forge 1.2.0 (commit abc123, built dev)\n"; got != want {
+		t.Errorf("printVersion(human) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"go_project/src/term"
+)
+
+func TestWithAltScreenToOrder(t *testing.T) {
+	var buf bytes.Buffer
+	err := term.WithAltScreenTo(&buf, func() {
+		io.WriteString(&buf, "dashboard")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "\x1b[?1049hdashboard\x1b[?1049l"; got != want {
+		t.Errorf("wrote %q, want %q", got, want)
+	}
+}
+
+func TestWithAltScreenToRestoresOnPanic(t *testing.T) {
+	var buf bytes.Buffer
+	defer func() {
+		if recover() == nil {
+			t.Error("expected the panic to propagate")
+		}
+		if got, want := buf.String(), "\x1b[?1049h\x1b[?1049l"; got != want {
+			t.Errorf("wrote %q, want %q", got, want)
+		}
+	}()
+	term.WithAltScreenTo(&buf, func() { panic("render failed") })
+}
+
+func TestAltScreenNoopWithoutTerminal(t *testing.T) {
+	if term.IsTerminal(os.Stdout) {
+		t.Skip("stdout is a terminal")
+	}
+	if err := term.EnterAltScreen(); err != nil {
+		t.Error(err)
+	}
+	if err := term.LeaveAltScreen(); err != nil {
+		t.Error(err)
+	}
+	ran := false
+	if err := term.WithAltScreen(func() { ran = true }); err != nil || !ran {
+		t.Errorf("WithAltScreen() = %v, ran %v; want fn to run without error", err, ran)
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"go_project/src/color"
+	"go_project/src/forge"
+	"go_project/src/theme"
+)
+
+func TestRenderBox(t *testing.T) {
+	got := forge.RenderBox(forge.Result{Status: "success", Message: "all good"})
+	want := strings.Join([]string{
+		"┌──────────┐",
+		"│ success  │",
+		"│ all good │",
+		"└──────────┘",
+	}, "\n")
+	if got != want {
+		t.Errorf("RenderBox() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderBoxStyles(t *testing.T) {
+	r := forge.Result{Status: "ok"}
+	if got := forge.RenderBoxWith(r, forge.BoxOptions{Style: forge.StyleDouble}); !strings.HasPrefix(got, "╔════╗") {
+		t.Errorf("unexpected double box:\n%s", got)
+	}
+	if got := forge.RenderBoxWith(r, forge.BoxOptions{Style: forge.StyleRounded}); !strings.HasSuffix(got, "╰────╯") {
+		t.Errorf("unexpected rounded box:\n%s", got)
+	}
+}
+
+func TestRenderBoxEmpty(t *testing.T) {
+	got := forge.RenderBox(forge.Result{})
+	want := "┌──┐\n│  │\n└──┘"
+	if got != want {
+		t.Errorf("RenderBox(empty) =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderBoxWrapsAndSplitsLines(t *testing.T) {
+	r := forge.Result{Status: "error", Message: "first line\nthe second line is much too long to fit"}
+	got := forge.RenderBoxWith(r, forge.BoxOptions{MaxWidth: 20})
+	lines := strings.Split(got, "\n")
+	if len(lines) < 6 {
+		t.Fatalf("expected wrapped message, got\n%s", got)
+	}
+	for _, line := range lines {
+		if n := utf8.RuneCountInString(line); n > 20 {
+			t.Errorf("line %q is %d columns wide, want <= 20", line, n)
+		}
+	}
+	if lines[2] != "│ first line       │" {
+		t.Errorf("expected embedded newline to start a new row, got %q", lines[2])
+	}
+}
+
+func TestRenderBoxColoredMessage(t *testing.T) {
+	r := forge.Result{Status: "ok", Message: "\x1b[31mred\x1b[0m text"}
+	got := forge.RenderBox(r)
+	want := strings.Join([]string{
+		"┌──────────┐",
+		"│ ok       │",
+		"│ \x1b[31mred\x1b[0m text │",
+		"└──────────┘",
+	}, "\n")
+	if got != want {
+		t.Errorf("RenderBox() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestRenderBoxTitleAlignment(t *testing.T) {
+	r := forge.Result{Status: "success", Message: "all systems go"}
+	cases := []struct {
+		align forge.TitleAlignment
+		want  string
+	}{
+		{forge.TitleLeft, "┌─ Status ───────┐"},
+		{forge.TitleCenter, "┌──── Status ────┐"},
+		{forge.TitleRight, "┌─────── Status ─┐"},
+	}
+	for _, c := range cases {
+		got := forge.RenderBoxWith(r, forge.BoxOptions{Title: "Status", TitleAlign: c.align})
+		if top := strings.Split(got, "\n")[0]; top != c.want {
+			t.Errorf("align %d: top border = %q, want %q", c.align, top, c.want)
+		}
+	}
+}
+
+func TestRenderBoxFooterAndWidening(t *testing.T) {
+	got := forge.RenderBoxWith(forge.Result{Status: "ok"}, forge.BoxOptions{
+		Footer:      "took 3s",
+		FooterAlign: forge.TitleRight,
+	})
+	want := strings.Join([]string{
+		"┌───────────┐",
+		"│ ok        │",
+		"└─ took 3s ─┘",
+	}, "\n")
+	if got != want {
+		t.Errorf("RenderBoxWith() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderBoxTitleTruncated(t *testing.T) {
+	got := forge.RenderBoxWith(forge.Result{Status: "ok"}, forge.BoxOptions{
+		Title:    "a very long title that cannot fit",
+		MaxWidth: 16,
+	})
+	lines := strings.Split(got, "\n")
+	if want := "┌─ a very lo… ─┐"; lines[0] != want {
+		t.Errorf("top border = %q, want %q", lines[0], want)
+	}
+	for _, line := range lines {
+		if n := utf8.RuneCountInString(line); n != 16 {
+			t.Errorf("line %q is %d columns wide, want 16", line, n)
+		}
+	}
+}
+
+func TestRenderBoxTitleAccentColor(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+
+	th := theme.DefaultTheme()
+	th.AccentColor = color.Magenta
+	got := forge.RenderBoxWith(forge.Result{Status: "ok"}, forge.BoxOptions{Title: "T", Theme: th})
+	if !strings.Contains(got, "\x1b[35mT\x1b[0m") {
+		t.Errorf("expected the title in the accent color, got %q", got)
+	}
+}
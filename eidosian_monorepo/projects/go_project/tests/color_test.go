@@ -0,0 +1,151 @@
+package main
+
+import (
+	"testing"
+
+	"go_project/src/color"
+)
+
+func TestColorizeForeground(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+
+	cases := []struct {
+		fg   color.Color
+		want string
+	}{
+		{color.Black, "\x1b[30mhi\x1b[0m"},
+		{color.Red, "\x1b[31mhi\x1b[0m"},
+		{color.Green, "\x1b[32mhi\x1b[0m"},
+		{color.Yellow, "\x1b[33mhi\x1b[0m"},
+		{color.Blue, "\x1b[34mhi\x1b[0m"},
+		{color.Magenta, "\x1b[35mhi\x1b[0m"},
+		{color.Cyan, "\x1b[36mhi\x1b[0m"},
+		{color.White, "\x1b[37mhi\x1b[0m"},
+		{color.BrightBlack, "\x1b[90mhi\x1b[0m"},
+		{color.BrightRed, "\x1b[91mhi\x1b[0m"},
+		{color.BrightWhite, "\x1b[97mhi\x1b[0m"},
+	}
+	for _, c := range cases {
+		if got := color.Colorize("hi", c.fg, color.Default); got != c.want {
+			t.Errorf("Colorize(fg=%d) = %q, want %q", c.fg, got, c.want)
+		}
+	}
+}
+
+func TestColorizeBackground(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+
+	if got, want := color.Colorize("hi", color.Default, color.Blue), "\x1b[44mhi\x1b[0m"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := color.Colorize("hi", color.Yellow, color.BrightCyan), "\x1b[33;106mhi\x1b[0m"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got := color.Colorize("hi", color.Default, color.Default); got != "hi" {
+		t.Errorf("expected no escapes for default colors, got %q", got)
+	}
+}
+
+func TestColorizeDisabled(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	if got := color.Colorize("hi", color.Red, color.Default); got != "hi" {
+		t.Errorf("expected NO_COLOR to disable escapes, got %q", got)
+	}
+
+	color.SetMode(color.Never)
+	defer color.SetMode(color.Auto)
+	if got := color.Colorize("hi", color.Red, color.Default); got != "hi" {
+		t.Errorf("expected Never mode to disable escapes, got %q", got)
+	}
+}
+
+func TestColorizeRGB(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+	t.Setenv("COLORTERM", "truecolor")
+
+	got := color.Colorize("hi", color.RGB(255, 136, 0), color.RGB(1, 2, 3))
+	if want := "\x1b[38;2;255;136;0;48;2;1;2;3mhi\x1b[0m"; got != want {
+		t.Errorf("Colorize() = %q, want %q", got, want)
+	}
+}
+
+func TestColorizeRGBDownsamples(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+	t.Setenv("COLORTERM", "")
+
+	t.Setenv("TERM", "xterm-256color")
+	if got, want := color.Colorize("hi", color.RGB(255, 0, 0), color.Default), "\x1b[38;5;196mhi\x1b[0m"; got != want {
+		t.Errorf("256-color Colorize() = %q, want %q", got, want)
+	}
+
+	t.Setenv("TERM", "xterm")
+	if got, want := color.Colorize("hi", color.RGB(250, 10, 10), color.Default), "\x1b[91mhi\x1b[0m"; got != want {
+		t.Errorf("16-color Colorize() = %q, want %q", got, want)
+	}
+	if got, want := color.Colorize("hi", color.Default, color.Indexed(21)), "\x1b[44mhi\x1b[0m"; got != want {
+		t.Errorf("16-color indexed Colorize() = %q, want %q", got, want)
+	}
+}
+
+func TestNearest256(t *testing.T) {
+	cases := []struct {
+		r, g, b uint8
+		want    uint8
+	}{
+		{0, 0, 0, 16},
+		{255, 255, 255, 231},
+		{255, 0, 0, 196},
+		{95, 135, 175, 67},
+		{128, 128, 128, 244},
+		{8, 8, 8, 232},
+		{240, 240, 240, 255},
+		{100, 40, 200, 56},
+	}
+	for _, c := range cases {
+		if got := color.Nearest256(c.r, c.g, c.b); got != c.want {
+			t.Errorf("Nearest256(%d, %d, %d) = %d, want %d", c.r, c.g, c.b, got, c.want)
+		}
+	}
+}
+
+func TestDetectColorDepth(t *testing.T) {
+	cases := []struct {
+		colorterm, term string
+		want            color.ColorDepth
+	}{
+		{"truecolor", "xterm", color.DepthTrueColor},
+		{"24bit", "", color.DepthTrueColor},
+		{"", "xterm-direct", color.DepthTrueColor},
+		{"", "screen-256color", color.Depth256},
+		{"", "xterm", color.Depth16},
+		{"", "", color.Depth16},
+	}
+	for _, c := range cases {
+		t.Setenv("COLORTERM", c.colorterm)
+		t.Setenv("TERM", c.term)
+		if got := color.DetectColorDepth(); got != c.want {
+			t.Errorf("DetectColorDepth(COLORTERM=%q, TERM=%q) = %d, want %d", c.colorterm, c.term, got, c.want)
+		}
+	}
+}
+
+func TestParseColorForms(t *testing.T) {
+	if c, err := color.Parse("#FF8800"); err != nil || c != color.RGB(255, 136, 0) {
+		t.Errorf("Parse(#FF8800) = %v, %v", c, err)
+	}
+	if c, err := color.Parse("208"); err != nil || c != color.Indexed(208) {
+		t.Errorf("Parse(208) = %v, %v", c, err)
+	}
+	if got := color.RGB(1, 2, 255).String(); got != "#0102ff" {
+		t.Errorf("String() = %q, want #0102ff", got)
+	}
+	for _, bad := range []string{"#12345", "#zzzzzz", "256", "mauve"} {
+		if _, err := color.Parse(bad); err == nil {
+			t.Errorf("Parse(%q) succeeded, want an error", bad)
+		}
+	}
+}
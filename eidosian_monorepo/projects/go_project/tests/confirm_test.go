@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"go_project/src/term"
+)
+
+func TestConfirmFromAnswers(t *testing.T) {
+	cases := []struct {
+		input string
+		def   bool
+		want  bool
+	}{
+		{"y\n", false, true},
+		{"Y\n", false, true},
+		{"yes\n", false, true},
+		{"YES\n", false, true},
+		{"n\n", true, false},
+		{"No\n", true, false},
+		{"\n", true, true},
+		{"\n", false, false},
+		{"  \r\n", true, true},
+		{"", true, true},
+		{"y", false, true},
+	}
+	for _, c := range cases {
+		var out bytes.Buffer
+		got, err := term.ConfirmFrom(strings.NewReader(c.input), &out, "Continue?", c.def)
+		if err != nil {
+			t.Errorf("ConfirmFrom(%q) error: %v", c.input, err)
+		}
+		if got != c.want {
+			t.Errorf("ConfirmFrom(%q, def=%v) = %v, want %v", c.input, c.def, got, c.want)
+		}
+	}
+}
+
+func TestConfirmFromHintAndRetry(t *testing.T) {
+	var out bytes.Buffer
+	got, err := term.ConfirmFrom(strings.NewReader("maybe\nn\n"), &out, "Delete?", true)
+	if err != nil || got {
+		t.Fatalf("ConfirmFrom() = %v, %v; want false", got, err)
+	}
+	want := "Delete? [Y/n] Please answer y or n.\nDelete? [Y/n] "
+	if out.String() != want {
+		t.Errorf("prompt output = %q, want %q", out.String(), want)
+	}
+
+	out.Reset()
+	term.ConfirmFrom(strings.NewReader("\n"), &out, "Delete?", false)
+	if out.String() != "Delete? [y/N] " {
+		t.Errorf("prompt output = %q", out.String())
+	}
+}
+
+func TestConfirmWithoutTerminalTakesDefault(t *testing.T) {
+	if term.IsTerminal(os.Stdin) {
+		t.Skip("stdin is a terminal")
+	}
+	for _, def := range []bool{true, false} {
+		got, err := term.Confirm("Proceed?", def)
+		if err != nil || got != def {
+			t.Errorf("Confirm(def=%v) = %v, %v", def, got, err)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	"go_project/src/color"
+)
+
+func TestLuminanceEndpoints(t *testing.T) {
+	if got := color.Luminance(color.RGB(0, 0, 0)); got != 0 {
+		t.Errorf("Luminance(black) = %v, want 0", got)
+	}
+	if got := color.Luminance(color.RGB(255, 255, 255)); math.Abs(got-1) > 1e-9 {
+		t.Errorf("Luminance(white) = %v, want 1", got)
+	}
+	if g, b := color.Luminance(color.RGB(0, 255, 0)), color.Luminance(color.RGB(0, 0, 255)); g <= b {
+		t.Errorf("expected green (%v) to be brighter than blue (%v)", g, b)
+	}
+}
+
+func TestContrastColor(t *testing.T) {
+	cases := []struct {
+		bg   color.Color
+		want color.Color
+	}{
+		{color.Indexed(0), color.BrightWhite},
+		{color.Indexed(15), color.Black},
+		{color.Indexed(21), color.BrightWhite},  // pure blue
+		{color.Indexed(226), color.Black},       // pure yellow
+		{color.Indexed(232), color.BrightWhite}, // darkest gray
+		{color.Indexed(255), color.Black},       // lightest gray
+		{color.RGB(128, 128, 128), color.Black},
+		{color.Blue, color.BrightWhite},
+	}
+	for _, c := range cases {
+		if got := color.ContrastColor(c.bg); got != c.want {
+			t.Errorf("ContrastColor(%v) = %v, want %v", c.bg, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"go_project/src/term"
+)
+
+func TestCursorMovement(t *testing.T) {
+	cases := []struct {
+		n        int
+		up, down string
+	}{
+		{-3, "", ""},
+		{0, "", ""},
+		{1, "\x1b[1A", "\x1b[1B"},
+		{5, "\x1b[5A", "\x1b[5B"},
+		{120, "\x1b[120A", "\x1b[120B"},
+	}
+	for _, c := range cases {
+		if got := term.MoveUp(c.n); got != c.up {
+			t.Errorf("MoveUp(%d) = %q, want %q", c.n, got, c.up)
+		}
+		if got := term.MoveDown(c.n); got != c.down {
+			t.Errorf("MoveDown(%d) = %q, want %q", c.n, got, c.down)
+		}
+	}
+}
+
+func TestCursorSequences(t *testing.T) {
+	cases := map[string][2]string{
+		"ClearLine":  {term.ClearLine(), "\r\x1b[2K"},
+		"ClearToEnd": {term.ClearToEnd(), "\x1b[K"},
+		"HideCursor": {term.HideCursor(), "\x1b[?25l"},
+		"ShowCursor": {term.ShowCursor(), "\x1b[?25h"},
+	}
+	for name, c := range cases {
+		if c[0] != c[1] {
+			t.Errorf("%s() = %q, want %q", name, c[0], c[1])
+		}
+	}
+}
+
+func TestCursorWriter(t *testing.T) {
+	var buf bytes.Buffer
+	c := term.NewCursor(&buf)
+	c.HideCursor()
+	c.MoveUp(2)
+	if buf.Len() != 0 {
+		t.Errorf("expected no output for a non-terminal, got %q", buf.String())
+	}
+
+	c.Enabled = true
+	c.HideCursor()
+	c.MoveUp(2)
+	c.MoveDown(0)
+	c.ClearLine()
+	c.ShowCursor()
+	if got, want := buf.String(), "\x1b[?25l\x1b[2A\r\x1b[2K\x1b[?25h"; got != want {
+		t.Errorf("wrote %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"go_project/src/table"
+)
+
+func TestDefinitionListAlignsColons(t *testing.T) {
+	var d table.DefinitionList
+	d.Add("id", "42")
+	d.Add("status", "success")
+	d.Add("description", "all good")
+
+	lines := strings.Split(d.Render(), "\n")
+	want := []string{
+		"id         : 42",
+		"status     : success",
+		"description: all good",
+	}
+	if strings.Join(lines, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("Render() =\n%s\nwant\n%s", d.Render(), strings.Join(want, "\n"))
+	}
+	col := strings.Index(lines[0], ":")
+	for _, line := range lines {
+		if strings.Index(line, ":") != col {
+			t.Errorf("colon of %q is not in column %d", line, col)
+		}
+	}
+}
+
+func TestDefinitionListWrapsValues(t *testing.T) {
+	d := table.DefinitionList{Width: 21}
+	d.Add("msg", "the quick brown fox jumps over")
+	d.Add("path", "/tmp\n/var")
+	d.Add("", "continued")
+
+	want := strings.Join([]string{
+		"msg : the quick brown",
+		"      fox jumps over",
+		"path: /tmp",
+		"      /var",
+		"      continued",
+	}, "\n")
+	if got := d.Render(); got != want {
+		t.Errorf("Render() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestDefinitionListCJKKeys(t *testing.T) {
+	var d table.DefinitionList
+	d.Add("名前", "alice")
+	d.Add("city", "tokyo")
+	if got, want := d.Render(), "名前: alice\ncity: tokyo"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"go_project/src/color"
+	"go_project/src/forge"
+	"go_project/src/progress"
+	"go_project/src/term"
+	"go_project/src/text"
+)
+
+func TestIsDumbTerminal(t *testing.T) {
+	for value, want := range map[string]bool{"dumb": true, "": true, "xterm-256color": false} {
+		t.Setenv("TERM", value)
+		if got := term.IsDumbTerminal(); got != want {
+			t.Errorf("IsDumbTerminal() with TERM=%q = %v, want %v", value, got, want)
+		}
+	}
+}
+
+func TestDumbTerminalHasNoCapabilities(t *testing.T) {
+	t.Setenv("TERM", "dumb")
+	t.Setenv("TERM_PROGRAM", "iTerm.app")
+	t.Setenv(term.EnvForceHyperlinks, "")
+	if caps := term.DetectCapabilities(os.Stdout); caps != (term.Capabilities{}) {
+		t.Errorf("DetectCapabilities() = %+v, want none", caps)
+	}
+	if term.HyperlinksSupported() {
+		t.Error("expected no hyperlinks on a dumb terminal")
+	}
+}
+
+func TestDumbTerminalOutputHasNoEscapes(t *testing.T) {
+	t.Setenv("TERM", "dumb")
+	t.Setenv("NO_COLOR", "")
+	t.Setenv(term.EnvForceHyperlinks, "")
+	color.SetMode(color.Auto)
+
+	r := forge.Result{Status: forge.StatusError, Message: "**disk** `full`"}
+	outputs := map[string]string{
+		"box":       forge.RenderBoxWith(r, forge.BoxOptions{Title: "Status"}),
+		"colorize":  color.Colorize("hi", color.Red, color.Blue),
+		"style":     color.Style{Attrs: color.Bold | color.Underline, Fg: color.Green}.Apply("hi"),
+		"gradient":  color.Gradient("banner", color.Red, color.Blue),
+		"markdown":  text.RenderMarkdown(r.Message),
+		"hyperlink": term.Hyperlink("docs", "https://example.com"),
+	}
+
+	bar := progress.New(4)
+	bar.Width = 20
+	var lines strings.Builder
+	bar.Output = &lines
+	for i := 0; i < 4; i++ {
+		bar.Increment()
+	}
+	outputs["progress"] = lines.String()
+	if !strings.HasSuffix(lines.String(), "100% (4/4)\n") {
+		t.Errorf("expected percentage lines, got %q", lines.String())
+	}
+
+	for name, out := range outputs {
+		if strings.ContainsAny(out, "\x1b\r") {
+			t.Errorf("%s output contains escapes or carriage returns: %q", name, out)
+		}
+	}
+}
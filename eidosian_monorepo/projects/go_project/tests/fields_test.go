@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go_project/src/forge"
+)
+
+func TestFieldsOmittedWhenEmpty(t *testing.T) {
+	data, err := json.Marshal(forge.Result{Status: "success", Message: "ok"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "fields") {
+		t.Errorf("expected no fields key, got %s", data)
+	}
+
+	var legacy struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(data, &legacy); err != nil || legacy.Status != "success" || legacy.Message != "ok" {
+		t.Errorf("legacy decode = %+v, %v", legacy, err)
+	}
+}
+
+func TestFieldsRoundTrip(t *testing.T) {
+	want := forge.Result{Status: "warning", Message: "slow", Code: forge.ExitError}.
+		WithField("latency_ms", float64(1250)).
+		WithField("host", "db-1").
+		WithField("tags", []any{"primary", "eu"})
+	for _, format := range []string{forge.FormatJSON, forge.FormatYAML} {
+		data, err := forge.Marshal(want, format)
+		if err != nil {
+			t.Fatalf("Marshal(%s): %v", format, err)
+		}
+		var got forge.Result
+		if err := forge.Unmarshal(data, format, &got); err != nil {
+			t.Fatalf("Unmarshal(%s): %v\n%s", format, err, data)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s round trip = %+v, want %+v", format, got, want)
+		}
+	}
+}
+
+func TestWithFieldCopies(t *testing.T) {
+	base := forge.Result{Status: "success", Message: "ok"}.WithField("a", 1)
+	derived := base.WithField("b", 2)
+	if len(base.Fields) != 1 || len(derived.Fields) != 2 {
+		t.Errorf("WithField modified its receiver: base %v, derived %v", base.Fields, derived.Fields)
+	}
+}
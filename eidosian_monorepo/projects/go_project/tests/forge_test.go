@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go_project/src/forge"
+)
+
+func TestRunSucceeds(t *testing.T) {
+	result := forge.Run()
+	if result.Status != "success" {
+		t.Fatalf("expected status success, got %q", result.Status)
+	}
+	if result.Code != forge.ExitOK {
+		t.Errorf("expected exit code %d, got %d", forge.ExitOK, result.Code)
+	}
+}
+
+func TestFailingResultExitCode(t *testing.T) {
+	for _, status := range []string{"error", "warning", ""} {
+		if got := forge.ExitCode(status); got != forge.ExitError {
+			t.Errorf("ExitCode(%q) = %d, want %d", status, got, forge.ExitError)
+		}
+	}
+	if got := forge.ExitCode("success"); got != forge.ExitOK {
+		t.Errorf("ExitCode(success) = %d, want %d", got, forge.ExitOK)
+	}
+}
+
+func TestResultValidate(t *testing.T) {
+	for _, status := range forge.Statuses {
+		r := forge.Result{Status: status, Message: "done"}
+		if err := r.Validate(); err != nil {
+			t.Errorf("Validate(%q) = %v, want nil", status, err)
+		}
+	}
+
+	invalid := []forge.Result{
+		{Status: "ok", Message: "done"},
+		{Status: "", Message: "done"},
+		{Status: "Success", Message: "done"},
+		{Status: forge.StatusSuccess},
+	}
+	for _, r := range invalid {
+		if err := r.Validate(); err == nil {
+			t.Errorf("Validate(%+v) = nil, want an error", r)
+		}
+	}
+}
+
+func TestRunReturnsValidResult(t *testing.T) {
+	if err := forge.Run().Validate(); err != nil {
+		t.Errorf("Run() produced an invalid result: %v", err)
+	}
+}
+
+func TestRunWithOptionsDryRun(t *testing.T) {
+	got := forge.RunWithOptions(forge.Options{DryRun: true})
+	want := forge.Result{Status: forge.StatusSuccess, Message: "dry-run: no actions performed", Code: forge.ExitOK}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RunWithOptions(DryRun) = %+v, want %+v", got, want)
+	}
+	if !reflect.DeepEqual(forge.RunWithOptions(forge.Options{}), forge.Run()) {
+		t.Error("expected zero options to match Run()")
+	}
+}
+
+func TestRunContextLive(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if got := forge.RunContext(ctx); !reflect.DeepEqual(got, forge.Run()) {
+		t.Errorf("RunContext(live) = %+v, want %+v", got, forge.Run())
+	}
+	got := forge.RunContextWithOptions(ctx, forge.Options{DryRun: true})
+	if got.Message != "dry-run: no actions performed" {
+		t.Errorf("RunContextWithOptions(DryRun) = %+v, want the dry-run result", got)
+	}
+}
+
+func TestRunContextPreCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	want := forge.Result{Status: forge.StatusError, Message: "canceled", Code: forge.ExitError}
+	if got := forge.RunContext(ctx); !reflect.DeepEqual(got, want) {
+		t.Errorf("RunContext(canceled) = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"go_project/src/forge"
+)
+
+func TestMarshalRoundTrip(t *testing.T) {
+	want := forge.Result{Status: "error", Message: "quote \" colon: tab\t unicode ✓", Code: forge.ExitError}
+	for _, format := range []string{forge.FormatJSON, forge.FormatYAML} {
+		data, err := forge.Marshal(want, format)
+		if err != nil {
+			t.Fatalf("Marshal(%s): %v", format, err)
+		}
+		var got forge.Result
+		if err := forge.Unmarshal(data, format, &got); err != nil {
+			t.Fatalf("Unmarshal(%s): %v\n%s", format, err, data)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s round trip = %+v, want %+v", format, got, want)
+		}
+	}
+}
+
+func TestMarshalJSONUnchanged(t *testing.T) {
+	r := forge.Run()
+	want, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := forge.Marshal(r, forge.FormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("Marshal(json) = %s, want %s", got, want)
+	}
+}
+
+func TestMarshalYAML(t *testing.T) {
+	got, err := forge.Marshal(forge.Result{Status: "success", Message: "hi"}, forge.FormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "status: \"success\"\nmessage: \"hi\"\ncode: 0\n"
+	if string(got) != want {
+		t.Errorf("Marshal(yaml) = %q, want %q", got, want)
+	}
+}
+
+func TestMarshalUnknownFormat(t *testing.T) {
+	if _, err := forge.Marshal(forge.Run(), "xml"); !errors.Is(err, forge.ErrUnknownFormat) {
+		t.Errorf("expected forge.ErrUnknownFormat, got %v", err)
+	}
+}
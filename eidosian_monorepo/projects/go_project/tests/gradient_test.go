@@ -0,0 +1,50 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"go_project/src/color"
+)
+
+func TestGradientEndpoints(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+	t.Setenv("COLORTERM", "truecolor")
+
+	got := color.Gradient("äb c✓", color.RGB(255, 0, 0), color.RGB(0, 0, 255))
+	if !strings.HasPrefix(got, "\x1b[38;2;255;0;0mä") {
+		t.Errorf("first rune should carry the start color, got %q", got)
+	}
+	if !strings.HasSuffix(got, "\x1b[38;2;0;0;255m✓\x1b[0m") {
+		t.Errorf("last rune should carry the end color, got %q", got)
+	}
+	// The space is the third of five runes, so c sits three quarters along
+	if !strings.Contains(got, "b \x1b[38;2;64;0;191mc") {
+		t.Errorf("whitespace should advance the gradient, got %q", got)
+	}
+	if strings.Count(got, "\x1b[38;2;") != 4 {
+		t.Errorf("expected one sequence per non-space rune, got %q", got)
+	}
+}
+
+func TestGradientDegradesWithoutTrueColor(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+	t.Setenv("COLORTERM", "")
+	t.Setenv("TERM", "xterm")
+
+	got := color.Gradient("banner", color.Red, color.Blue)
+	if want := "\x1b[31mbanner\x1b[0m"; got != want {
+		t.Errorf("Gradient() = %q, want %q", got, want)
+	}
+}
+
+func TestGradientDisabled(t *testing.T) {
+	color.SetMode(color.Never)
+	defer color.SetMode(color.Auto)
+
+	if got := color.Gradient("plain", color.Red, color.Blue); got != "plain" {
+		t.Errorf("Gradient() = %q, want plain text", got)
+	}
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+
+	"go_project/src/progress"
+)
+
+var screenControl = regexp.MustCompile("^\x1b\\[(\\d*)([ABK])")
+
+// screen replays the cursor movement, line clearing and carriage returns in
+// out and returns the resulting lines
+func screen(out string) []string {
+	var lines []string
+	row, col := 0, 0
+	put := func(r rune) {
+		for len(lines) <= row {
+			lines = append(lines, "")
+		}
+		line := []rune(lines[row])
+		for len(line) < col {
+			line = append(line, ' ')
+		}
+		if col < len(line) {
+			line[col] = r
+		} else {
+			line = append(line, r)
+		}
+		lines[row] = string(line)
+		col++
+	}
+	for out != "" {
+		if m := screenControl.FindStringSubmatch(out); m != nil {
+			n, _ := strconv.Atoi(m[1])
+			switch m[2] {
+			case "A":
+				row -= n
+			case "B":
+				row += n
+			case "K":
+				if row >= len(lines) {
+					break
+				}
+				if n == 2 {
+					lines[row] = ""
+				} else if line := []rune(lines[row]); col < len(line) {
+					lines[row] = string(line[:col])
+				}
+			}
+			out = out[len(m[0]):]
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(out)
+		out = out[size:]
+		switch r {
+		case '\r':
+			col = 0
+		case '\n':
+			row, col = row+1, 0
+		default:
+			put(r)
+		}
+	}
+	return lines
+}
+
+func TestProgressGroupConcurrent(t *testing.T) {
+	var buf bytes.Buffer
+	g := progress.NewProgressGroup(&buf)
+	g.Interactive = true
+	g.Width = 40
+
+	download := g.Add("download", 200)
+	extract := g.Add("extract", 50)
+	var wg sync.WaitGroup
+	for _, job := range []struct {
+		bar   *progress.ProgressBar
+		steps int
+	}{{download, 200}, {extract, 50}} {
+		wg.Add(1)
+		go func(bar *progress.ProgressBar, steps int) {
+			defer wg.Done()
+			for i := 0; i < steps; i++ {
+				g.Increment(bar)
+			}
+		}(job.bar, job.steps)
+	}
+	wg.Wait()
+
+	final := strings.Split(g.Render(), "\n")
+	if len(final) != 2 ||
+		!strings.HasPrefix(final[0], "download [") || !strings.HasSuffix(final[0], "] 100% (200/200)") ||
+		!strings.HasPrefix(final[1], "extract [") || !strings.HasSuffix(final[1], "] 100% (50/50)") {
+		t.Errorf("Render() = %q, want both bars at 100%%", final)
+	}
+
+	got := screen(buf.String())
+	if len(got) != 2 || got[0] != final[0] || got[1] != final[1] {
+		t.Errorf("screen shows\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(final, "\n"))
+	}
+}
+
+func TestProgressGroupPipedPrintsCompletions(t *testing.T) {
+	var buf bytes.Buffer
+	g := progress.NewProgressGroup(&buf)
+	a := g.Add("a", 2)
+	b := g.Add("b", 1)
+	g.Increment(a)
+	g.Increment(b)
+	g.Increment(a)
+	g.Increment(a)
+	if got, want := buf.String(), "b 100% (1/1)\na 100% (2/2)\n"; got != want {
+		t.Errorf("wrote %q, want %q", got, want)
+	}
+}
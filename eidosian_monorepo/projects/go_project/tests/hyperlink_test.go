@@ -0,0 +1,47 @@
+package main
+
+import (
+	"testing"
+
+	"go_project/src/term"
+)
+
+func TestHyperlinkEmitsOSC8(t *testing.T) {
+	t.Setenv(term.EnvForceHyperlinks, "1")
+	got := term.Hyperlink("docs", "https://example.com/docs")
+	want := "\x1b]8;;https://example.com/docs\x1b\\docs\x1b]8;;\x1b\\"
+	if got != want {
+		t.Errorf("Hyperlink() = %q, want %q", got, want)
+	}
+}
+
+func TestHyperlinkFallback(t *testing.T) {
+	// Tests run with stdout redirected, so detection alone never enables links
+	t.Setenv(term.EnvForceHyperlinks, "")
+	t.Setenv("TERM_PROGRAM", "iTerm.app")
+	if got, want := term.Hyperlink("docs", "https://example.com"), "docs (https://example.com)"; got != want {
+		t.Errorf("Hyperlink() = %q, want %q", got, want)
+	}
+
+	t.Setenv(term.EnvForceHyperlinks, "false")
+	if term.HyperlinksSupported() {
+		t.Error("expected false override to disable hyperlinks")
+	}
+}
+
+func TestHyperlinkEdgeCases(t *testing.T) {
+	t.Setenv(term.EnvForceHyperlinks, "true")
+	if got := term.Hyperlink("bare", ""); got != "bare" {
+		t.Errorf("empty URL: got %q, want bare text", got)
+	}
+	if got := term.Hyperlink("bad", "https://x\x1b]evil"); got != "bad" {
+		t.Errorf("control characters in URL: got %q, want bare text", got)
+	}
+
+	inner := term.Hyperlink("inner", "https://inner.example")
+	got := term.Hyperlink("see "+inner, "https://outer.example")
+	want := "\x1b]8;;https://outer.example\x1b\\see inner\x1b]8;;\x1b\\"
+	if got != want {
+		t.Errorf("nested link: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"go_project/src/text"
+)
+
+func TestIndentWriterSplitWrites(t *testing.T) {
+	var buf bytes.Buffer
+	w := text.NewIndentWriter(&buf, "  ")
+
+	io.WriteString(w, "root\n")
+	w.Push()
+	io.WriteString(w, "chi")
+	io.WriteString(w, "ld one")
+	io.WriteString(w, "\nchild two\n")
+	w.Push()
+	io.WriteString(w, "grand")
+	io.WriteString(w, "child\n\n")
+	w.Pop()
+	io.WriteString(w, "tail without newline")
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "root\n  child one\n  child two\n    grandchild\n\n  tail without newline"
+	if got := buf.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestIndentWriterBuffersPartialLines(t *testing.T) {
+	var buf bytes.Buffer
+	w := text.NewIndentWriter(&buf, "\t")
+	w.Push()
+	n, err := io.WriteString(w, "partial")
+	if err != nil || n != len("partial") {
+		t.Fatalf("Write() = %d, %v", n, err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected partial line to be held back, got %q", buf.String())
+	}
+	io.WriteString(w, " line\n")
+	if got := buf.String(); got != "\tpartial line\n" {
+		t.Errorf("got %q", got)
+	}
+
+	w.Pop()
+	w.Pop()
+	if w.Depth() != 0 {
+		t.Errorf("Depth() = %d, want 0", w.Depth())
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go_project/src/forge"
+)
+
+func TestReadResultRendersThroughFormats(t *testing.T) {
+	in := strings.NewReader(`{"status":"error","message":"disk full"}`)
+	r, err := forge.ReadResult(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Code != forge.ExitError {
+		t.Errorf("expected error status to imply exit code %d, got %d", forge.ExitError, r.Code)
+	}
+
+	out, err := forge.Marshal(r, forge.FormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "status: \"error\"\nmessage: \"disk full\"\ncode: 1\n"
+	if string(out) != want {
+		t.Errorf("rendered %q, want %q", out, want)
+	}
+}
+
+func TestReadResultAcceptsForgeOutput(t *testing.T) {
+	in := strings.NewReader("Result: {\"status\":\"success\",\"message\":\"hi\",\"code\":0}\n")
+	r, err := forge.ReadResult(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(r, forge.Result{Status: "success", Message: "hi"}) {
+		t.Errorf("unexpected result %+v", r)
+	}
+}
+
+func TestReadResultErrors(t *testing.T) {
+	if _, err := forge.ReadResult(strings.NewReader(" \n")); !errors.Is(err, io.EOF) {
+		t.Errorf("expected io.EOF for empty input, got %v", err)
+	}
+	if _, err := forge.ReadResult(strings.NewReader("{not json")); err == nil || errors.Is(err, io.EOF) {
+		t.Errorf("expected a decode error, got %v", err)
+	}
+}
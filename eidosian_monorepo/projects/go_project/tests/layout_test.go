@@ -0,0 +1,57 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"go_project/src/color"
+	"go_project/src/forge"
+	"go_project/src/text"
+)
+
+func TestHStackBoxes(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+
+	status := forge.RenderBox(forge.Result{Status: "success"})
+	detail := forge.RenderBoxWith(forge.Result{Status: "error", Message: "the disk is full, free some space"}, forge.BoxOptions{MaxWidth: 20})
+	got := text.HStack(status, detail)
+
+	lines := strings.Split(got, "\n")
+	if want := strings.Count(detail, "\n") + 1; len(lines) != want {
+		t.Fatalf("got %d rows, want %d:\n%s", len(lines), want, got)
+	}
+	width := visibleWidth(lines[0])
+	for i, line := range lines {
+		if w := visibleWidth(line); w != width {
+			t.Errorf("row %d is %d columns wide, want %d: %q", i, w, width, line)
+		}
+	}
+	if !strings.HasPrefix(text.StripANSI(lines[len(lines)-1]), strings.Repeat(" ", visibleWidth(strings.Split(status, "\n")[0]))) {
+		t.Errorf("expected the shorter left block to be padded with blank rows:\n%s", got)
+	}
+}
+
+func TestHStackShorterRight(t *testing.T) {
+	got := text.HStack("a\nbb\nccc", "日本")
+	want := "a  日本\nbb     \nccc    "
+	if got != want {
+		t.Errorf("HStack() = %q, want %q", got, want)
+	}
+}
+
+func TestVStackPadsToWidestBlock(t *testing.T) {
+	top := forge.RenderBox(forge.Result{Status: "ok"})
+	bottom := forge.RenderBox(forge.Result{Status: "warning", Message: "slow"})
+	got := text.VStack(top, bottom)
+
+	lines := strings.Split(got, "\n")
+	if len(lines) != 7 {
+		t.Fatalf("got %d rows, want 7:\n%s", len(lines), got)
+	}
+	for i, line := range lines {
+		if w := visibleWidth(line); w != 11 {
+			t.Errorf("row %d is %d columns wide, want 11: %q", i, w, line)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"go_project/src/logx"
+)
+
+func TestLoggerDropsMessagesBelowLevel(t *testing.T) {
+	var out, errOut bytes.Buffer
+	l := logx.New(&out, &errOut, logx.LevelWarn)
+	l.Debug("debug %d", 1)
+	l.Info("info %d", 2)
+	l.Warn("warn %d", 3)
+	l.Error("error %d", 4)
+
+	if s := out.String(); strings.Contains(s, "debug 1") || strings.Contains(s, "info 2") {
+		t.Errorf("expected debug and info to be dropped, got %q", s)
+	}
+	if s := out.String(); !strings.Contains(s, "WARN warn 3") {
+		t.Errorf("expected warning on out, got %q", s)
+	}
+	if s := errOut.String(); !strings.Contains(s, "ERROR error 4") || strings.Contains(s, "warn") {
+		t.Errorf("expected only the error on errOut, got %q", s)
+	}
+}
+
+func TestLoggerErrorLevelOnlyWritesErrors(t *testing.T) {
+	var out, errOut bytes.Buffer
+	l := logx.New(&out, &errOut, logx.LevelError)
+	l.Warn("ignored")
+	l.Error("kept")
+	if out.Len() != 0 {
+		t.Errorf("expected no output below error, got %q", out.String())
+	}
+	if !strings.Contains(errOut.String(), "kept") {
+		t.Errorf("expected error output, got %q", errOut.String())
+	}
+}
+
+func TestFromEnvLevel(t *testing.T) {
+	t.Setenv(logx.EnvLevel, "Debug")
+	if got := logx.FromEnv().Level(); got != logx.LevelDebug {
+		t.Errorf("level = %v, want DEBUG", got)
+	}
+	t.Setenv(logx.EnvLevel, "")
+	if got := logx.FromEnv().Level(); got != logx.LevelInfo {
+		t.Errorf("default level = %v, want INFO", got)
+	}
+	if _, err := logx.ParseLevel("loud"); err == nil {
+		t.Error("expected an error for an unknown level")
+	}
+}
+
+func TestFromEnvToReportsInvalidLevel(t *testing.T) {
+	t.Setenv(logx.EnvLevel, "loud")
+	var out, errOut bytes.Buffer
+	l := logx.FromEnvTo(&out, &errOut)
+	if l.Level() != logx.LevelInfo {
+		t.Errorf("level = %v, want INFO", l.Level())
+	}
+	if !strings.Contains(out.String(), `WARN FORGE_LOG_LEVEL: unknown log level "loud"`) {
+		t.Errorf("expected the warning on the given writer, got %q", out.String())
+	}
+}
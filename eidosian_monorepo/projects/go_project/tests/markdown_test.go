@@ -0,0 +1,58 @@
+package main
+
+import (
+	"testing"
+
+	"go_project/src/color"
+	"go_project/src/text"
+)
+
+func TestRenderMarkdownStyled(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+
+	cases := []struct {
+		in, want string
+	}{
+		{"a **bold** move", "a \x1b[1mbold\x1b[0m move"},
+		{"run `make test` now", "run \x1b[36mmake test\x1b[0m now"},
+		{"**use `go vet`**", "\x1b[1muse \x1b[36mgo vet\x1b[0m\x1b[0m"},
+		{"- first\n- second", "  • first\n  • second"},
+		{"- top\n  - nested\n    - deeper", "  • top\n    • nested\n      • deeper"},
+		{"- **done**", "  • \x1b[1mdone\x1b[0m"},
+	}
+	for _, c := range cases {
+		if got := text.RenderMarkdown(c.in); got != c.want {
+			t.Errorf("RenderMarkdown(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestRenderMarkdownLeavesUnknownSyntax(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+
+	for _, in := range []string{
+		"# heading",
+		"_italic_ and [link](http://x)",
+		"unmatched ** marker",
+		"empty **** and `` spans",
+		"* star bullet",
+		"-not a bullet",
+	} {
+		if got := text.RenderMarkdown(in); got != in {
+			t.Errorf("RenderMarkdown(%q) = %q, want it unchanged", in, got)
+		}
+	}
+}
+
+func TestRenderMarkdownWithoutColor(t *testing.T) {
+	color.SetMode(color.Never)
+	defer color.SetMode(color.Auto)
+
+	in := "**Deploy** finished\n- ran `migrate`\n  - **3** tables"
+	want := "Deploy finished\n  • ran migrate\n    • 3 tables"
+	if got := text.RenderMarkdown(in); got != want {
+		t.Errorf("RenderMarkdown() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"testing"
+
+	"go_project/src/text"
+)
+
+func TestPadRightAndLeft(t *testing.T) {
+	if got := text.PadRight("ok", 5); got != "ok   " {
+		t.Errorf("PadRight() = %q, want %q", got, "ok   ")
+	}
+	if got := text.PadLeft("ok", 5); got != "   ok" {
+		t.Errorf("PadLeft() = %q, want %q", got, "   ok")
+	}
+	if got := text.PadRight("toolong", 3); got != "toolong" {
+		t.Errorf("PadRight() = %q, want input unchanged", got)
+	}
+}
+
+func TestCenterTextOddRemainderGoesRight(t *testing.T) {
+	if got := text.CenterText("ab", 5); got != " ab  " {
+		t.Errorf("CenterText() = %q, want %q", got, " ab  ")
+	}
+	if got := text.CenterText("abc", 7); got != "  abc  " {
+		t.Errorf("CenterText() = %q, want %q", got, "  abc  ")
+	}
+}
+
+func TestPadIgnoresANSI(t *testing.T) {
+	s := "\x1b[31mred\x1b[0m"
+	if got, want := text.PadRight(s, 6), s+"   "; got != want {
+		t.Errorf("PadRight() = %q, want %q", got, want)
+	}
+	if got, want := text.CenterText(s, 6), " "+s+"  "; got != want {
+		t.Errorf("CenterText() = %q, want %q", got, want)
+	}
+}
+
+func TestPadCountsWideRunes(t *testing.T) {
+	// Each ideograph occupies two columns
+	if got, want := text.PadLeft("日本", 6), "  日本"; got != want {
+		t.Errorf("PadLeft() = %q, want %q", got, want)
+	}
+	if got, want := text.CenterText("語", 5), " 語  "; got != want {
+		t.Errorf("CenterText() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go_project/src/progress"
+)
+
+func TestProgressBarRender(t *testing.T) {
+	bar := progress.New(10)
+	bar.Interactive = true
+	bar.Width = 23
+	for i := 0; i < 4; i++ {
+		bar.Increment()
+	}
+	if got, want := bar.Render(), "\r[████░░░░░░] 40% (4/10)"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+
+	bar.Interactive = false
+	if got, want := bar.Render(), "40% (4/10)\n"; got != want {
+		t.Errorf("Render() piped = %q, want %q", got, want)
+	}
+}
+
+func TestProgressBarClampsAtTotal(t *testing.T) {
+	bar := progress.New(3)
+	bar.Interactive = true
+	bar.Width = 20
+	for i := 0; i < 10; i++ {
+		bar.Increment()
+	}
+	if got := bar.Percent(); got != 100 {
+		t.Errorf("Percent() = %d, want 100", got)
+	}
+	got := bar.Render()
+	if !strings.HasSuffix(got, "] 100% (3/3)") || strings.Contains(got, "░") {
+		t.Errorf("expected a full bar, got %q", got)
+	}
+}
+
+func TestProgressBarZeroTotal(t *testing.T) {
+	bar := progress.New(0)
+	bar.Interactive = true
+	bar.Width = 15
+	if got, want := bar.Render(), "\r[███░░░░] (0/?)"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+	bar.Increment()
+	bar.Increment()
+	if got, want := bar.Render(), "\r[░░███░░] (2/?)"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+	if got := bar.Percent(); got != -1 {
+		t.Errorf("Percent() = %d, want -1", got)
+	}
+
+	bar.Interactive = false
+	if got, want := bar.Render(), "(2/?)\n"; got != want {
+		t.Errorf("Render() piped = %q, want %q", got, want)
+	}
+}
+
+// countingWriter records how many writes it receives and the last one
+type countingWriter struct {
+	writes int
+	last   string
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	w.last = string(p)
+	return len(p), nil
+}
+
+func TestProgressBarThrottlesRepaints(t *testing.T) {
+	clock := time.Unix(0, 0)
+	out := &countingWriter{}
+	bar := progress.New(10000)
+	bar.Interactive = true
+	bar.Width = 30
+	bar.Output = out
+	bar.RedrawInterval = 10 * time.Millisecond
+	bar.Now = func() time.Time { return clock }
+
+	// Everything within one interval coalesces into the first repaint
+	for i := 0; i < 1000; i++ {
+		bar.Increment()
+	}
+	if out.writes != 1 {
+		t.Fatalf("got %d writes within one interval, want 1", out.writes)
+	}
+
+	clock = clock.Add(10 * time.Millisecond)
+	bar.Increment()
+	if out.writes != 2 || !strings.Contains(out.last, "(1001/10000)") {
+		t.Errorf("expected a repaint once the interval passed, got %d writes, last %q", out.writes, out.last)
+	}
+
+	for i := 0; i < 9000; i++ {
+		bar.Increment()
+	}
+	if out.writes != 3 || !strings.HasSuffix(out.last, "100% (10000/10000)") {
+		t.Errorf("expected the completing step to repaint, got %d writes, last %q", out.writes, out.last)
+	}
+	bar.Increment()
+	if out.writes != 3 {
+		t.Errorf("expected no repaint past completion, got %d writes", out.writes)
+	}
+}
+
+func TestProgressBarPipedLinesAreCoarse(t *testing.T) {
+	clock := time.Unix(0, 0)
+	var buf strings.Builder
+	bar := progress.New(10000)
+	bar.Interactive = false
+	bar.Output = &buf
+	bar.Now = func() time.Time { return clock }
+
+	// Ten seconds of steps, one every millisecond
+	for i := 0; i < 10000; i++ {
+		clock = clock.Add(time.Millisecond)
+		bar.Increment()
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 11 {
+		t.Errorf("printed %d lines, want one per DefaultLogInterval plus the last:\n%s", len(lines), buf.String())
+	}
+	if lines[len(lines)-1] != "100% (10000/10000)" {
+		t.Errorf("last line = %q, want the completed bar", lines[len(lines)-1])
+	}
+
+	// Even with no throttle, a line is only printed when the percentage moves
+	buf.Reset()
+	bar = progress.New(1000)
+	bar.Interactive = false
+	bar.Output = &buf
+	bar.RedrawInterval = time.Nanosecond
+	bar.Now = func() time.Time {
+		clock = clock.Add(time.Millisecond)
+		return clock
+	}
+	for i := 0; i < 1000; i++ {
+		bar.Increment()
+	}
+	if n := strings.Count(buf.String(), "\n"); n != 100 {
+		t.Errorf("printed %d lines, want one per whole percent", n)
+	}
+}
+
+func TestProgressBarNewToDetectsFromWriter(t *testing.T) {
+	t.Setenv("TERM", "xterm-256color")
+	var buf strings.Builder
+	bar := progress.NewTo(&buf, 2)
+	if bar.Interactive {
+		t.Error("expected a bar writing to a buffer not to be interactive")
+	}
+	bar.Increment()
+	bar.Increment()
+	if !strings.HasSuffix(buf.String(), "100% (2/2)\n") || strings.Contains(buf.String(), "\r") {
+		t.Errorf("expected percentage lines in the buffer, got %q", buf.String())
+	}
+}
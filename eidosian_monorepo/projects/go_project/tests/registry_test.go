@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"go_project/src/forge"
+)
+
+func TestRegisterRendererDispatch(t *testing.T) {
+	csv := func(r forge.Result) ([]byte, error) {
+		return []byte(fmt.Sprintf("%s,%s,%d\n", r.Status, r.Message, r.Code)), nil
+	}
+	if err := forge.RegisterRenderer("test-csv", csv); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { forge.UnregisterRenderer("test-csv") })
+
+	format, err := forge.ResolveFormat("test-csv", "", false)
+	if err != nil {
+		t.Fatalf("ResolveFormat() error = %v", err)
+	}
+	got, err := forge.Marshal(forge.Result{Status: "success", Message: "ok"}, format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "success,ok,0\n"; string(got) != want {
+		t.Errorf("Marshal(test-csv) = %q, want %q", got, want)
+	}
+
+	if err := forge.RegisterRenderer("test-csv", csv); err == nil {
+		t.Error("expected duplicate registration to fail")
+	}
+}
+
+func TestRegisterRendererRejectsInvalidNames(t *testing.T) {
+	noop := func(forge.Result) ([]byte, error) { return nil, nil }
+	for _, name := range []string{"", forge.FormatJSON, forge.FormatYAML, forge.FormatHuman} {
+		if err := forge.RegisterRenderer(name, noop); err == nil {
+			t.Errorf("RegisterRenderer(%q) succeeded, want error", name)
+		}
+	}
+}
+
+func TestUnregisterRenderer(t *testing.T) {
+	noop := func(forge.Result) ([]byte, error) { return nil, nil }
+	if err := forge.RegisterRenderer("test-removed", noop); err != nil {
+		t.Fatal(err)
+	}
+	forge.UnregisterRenderer("test-removed")
+	if _, err := forge.ResolveFormat("test-removed", "", false); err == nil {
+		t.Error("expected an unregistered format to be rejected")
+	}
+	if err := forge.RegisterRenderer("test-removed", noop); err != nil {
+		t.Errorf("re-registering after removal failed: %v", err)
+	}
+	forge.UnregisterRenderer("test-removed")
+
+	forge.UnregisterRenderer(forge.FormatJSON)
+	if _, err := forge.Marshal(forge.Result{Status: "success"}, forge.FormatJSON); err != nil {
+		t.Errorf("expected built-in formats to survive removal, got %v", err)
+	}
+}
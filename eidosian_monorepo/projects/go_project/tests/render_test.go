@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"go_project/src/color"
+	"go_project/src/forge"
+	"go_project/src/term"
+	"go_project/src/theme"
+)
+
+func TestRenderHuman(t *testing.T) {
+	color.SetMode(color.Never)
+	defer color.SetMode(color.Auto)
+
+	got := forge.RenderHuman(forge.Result{Status: "error", Message: "boom"}, theme.DefaultTheme())
+	if want := "error: boom"; got != want {
+		t.Errorf("RenderHuman() = %q, want %q", got, want)
+	}
+}
+
+func TestRenderHumanUsesTheme(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+
+	th := theme.DefaultTheme()
+	th.SuccessColor = color.Cyan
+	got := forge.RenderHuman(forge.Result{Status: "success", Message: "ok"}, th)
+	if want := "\x1b[36msuccess\x1b[0m: ok"; got != want {
+		t.Errorf("RenderHuman() = %q, want %q", got, want)
+	}
+}
+
+func TestRenderHumanFields(t *testing.T) {
+	color.SetMode(color.Never)
+	defer color.SetMode(color.Auto)
+	t.Setenv("COLUMNS", "80")
+
+	r := forge.Result{Status: "success", Message: "deployed"}.
+		WithField("region", "eu-west-1").
+		WithField("replicas", 3)
+	got := forge.RenderHuman(r, theme.DefaultTheme())
+	if want := "success: deployed\n  region  : eu-west-1\n  replicas: 3"; got != want {
+		t.Errorf("RenderHuman() = %q, want %q", got, want)
+	}
+}
+
+func TestRenderToBuffer(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+
+	r := forge.Result{Status: "success", Message: "built"}
+	cases := []struct {
+		opts forge.RenderOptions
+		want string
+	}{
+		{forge.RenderOptions{Format: forge.FormatHuman, NoColor: true}, "success: built\n"},
+		{forge.RenderOptions{Format: forge.FormatJSON}, `Result: {"status":"success","message":"built","code":0}` + "\n"},
+		{forge.RenderOptions{Format: forge.FormatYAML}, "status: \"success\"\nmessage: \"built\"\ncode: 0\n"},
+	}
+	for _, c := range cases {
+		var buf bytes.Buffer
+		if err := forge.Render(&buf, r, c.opts); err != nil {
+			t.Fatalf("Render(%s) error: %v", c.opts.Format, err)
+		}
+		if buf.String() != c.want {
+			t.Errorf("Render(%s) wrote %q, want %q", c.opts.Format, buf.String(), c.want)
+		}
+	}
+}
+
+func TestRenderTimestamps(t *testing.T) {
+	color.SetMode(color.Never)
+	defer color.SetMode(color.Auto)
+
+	now := func() time.Time { return time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC) }
+	var buf bytes.Buffer
+	err := forge.Render(&buf, forge.Result{Status: "success", Message: "ok"}, forge.RenderOptions{
+		Format:     forge.FormatHuman,
+		Timestamps: true,
+		Now:        now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "2024-05-01T12:00:00Z success: ok\n"; buf.String() != want {
+		t.Errorf("Render() wrote %q, want %q", buf.String(), want)
+	}
+}
+
+func TestRenderMarshalError(t *testing.T) {
+	var buf bytes.Buffer
+	err := forge.Render(&buf, forge.Result{Status: "success"}, forge.RenderOptions{Format: "xml"})
+	if err == nil || !strings.HasPrefix(err.Error(), "marshal result: ") {
+		t.Errorf("Render(xml) error = %v, want a marshal error", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing written on error, got %q", buf.String())
+	}
+}
+
+func TestRenderIcons(t *testing.T) {
+	color.SetMode(color.Never)
+	defer color.SetMode(color.Auto)
+
+	r := forge.Result{Status: "error", Message: "boom"}
+	emoji := &term.Capabilities{Emoji: true}
+	cases := []struct {
+		name string
+		opts forge.RenderOptions
+		want string
+	}{
+		{"emoji terminal", forge.RenderOptions{Capabilities: emoji}, "✗ error: boom\n"},
+		{"ascii flag", forge.RenderOptions{Capabilities: emoji, ASCII: true}, "[ERR] error: boom\n"},
+		{"plain terminal", forge.RenderOptions{Capabilities: &term.Capabilities{}}, "[ERR] error: boom\n"},
+		{"detected from buffer", forge.RenderOptions{}, "[ERR] error: boom\n"},
+	}
+	for _, c := range cases {
+		c.opts.Format, c.opts.Icons = forge.FormatHuman, true
+		var buf bytes.Buffer
+		if err := forge.Render(&buf, r, c.opts); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != c.want {
+			t.Errorf("%s: Render() wrote %q, want %q", c.name, buf.String(), c.want)
+		}
+	}
+}
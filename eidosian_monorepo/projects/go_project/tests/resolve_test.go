@@ -0,0 +1,50 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"go_project/src/forge"
+)
+
+func TestResolveFormatMatrix(t *testing.T) {
+	values := []string{"", forge.FormatHuman, forge.FormatJSON, forge.FormatYAML, "xml"}
+	for _, flag := range values {
+		for _, env := range values {
+			for _, tty := range []bool{false, true} {
+				got, err := forge.ResolveFormat(flag, env, tty)
+
+				if flag == "xml" || env == "xml" {
+					if !errors.Is(err, forge.ErrUnknownFormat) {
+						t.Errorf("ResolveFormat(%q, %q, %v) error = %v, want ErrUnknownFormat", flag, env, tty, err)
+					}
+					continue
+				}
+				want := flag
+				switch {
+				case want != "":
+				case env != "":
+					want = env
+				case tty:
+					want = forge.FormatHuman
+				default:
+					want = forge.FormatJSON
+				}
+				if err != nil || got != want {
+					t.Errorf("ResolveFormat(%q, %q, %v) = %q, %v; want %q", flag, env, tty, got, err, want)
+				}
+			}
+		}
+	}
+}
+
+func TestResolveFormatErrorNamesSource(t *testing.T) {
+	_, err := forge.ResolveFormat("", "toml", false)
+	if err == nil || err.Error() != `FORGE_FORMAT: unknown format "toml" (want human, json or yaml)` {
+		t.Errorf("unexpected error %v", err)
+	}
+	_, err = forge.ResolveFormat("toml", "", false)
+	if err == nil || err.Error() != `--format: unknown format "toml" (want human, json or yaml)` {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"go_project/src/term"
+)
+
+func TestRestoreTerminal(t *testing.T) {
+	var buf bytes.Buffer
+	if err := term.RestoreTerminal(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "\r\x1b[2K\x1b[?25h"; got != want {
+		t.Errorf("RestoreTerminal wrote %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"go_project/src/forge"
+	"go_project/src/table"
+)
+
+func TestResultSetSummary(t *testing.T) {
+	var set forge.ResultSet
+	set.Add(forge.Result{Status: forge.StatusSuccess, Message: "a"})
+	set.Add(forge.Result{Status: forge.StatusWarning, Message: "b"})
+	set.Add(forge.Result{Status: forge.StatusSuccess, Message: "c"})
+
+	got := set.Summary()
+	want := forge.Result{Status: forge.StatusSuccess, Message: "3 results: 2 success, 1 warning, 0 error"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Summary() = %+v, want %+v", got, want)
+	}
+
+	set.Add(forge.Result{Status: forge.StatusError, Message: "d", Code: forge.ExitError})
+	got = set.Summary()
+	if got.Status != forge.StatusError || got.Code != forge.ExitError {
+		t.Errorf("expected an error rollup, got %+v", got)
+	}
+	if got.Message != "4 results: 2 success, 1 warning, 1 error" {
+		t.Errorf("unexpected message %q", got.Message)
+	}
+}
+
+func TestResultSetSummaryEmpty(t *testing.T) {
+	var set forge.ResultSet
+	got := set.Summary()
+	if got.Status != forge.StatusSuccess || got.Code != forge.ExitOK {
+		t.Errorf("expected empty set to succeed, got %+v", got)
+	}
+	if err := got.Validate(); err != nil {
+		t.Errorf("expected a valid summary, got %v", err)
+	}
+}
+
+func TestResultSetJSON(t *testing.T) {
+	var set forge.ResultSet
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "[]" {
+		t.Errorf("empty set marshalled to %s, want []", data)
+	}
+
+	set.Add(forge.Result{Status: forge.StatusSuccess, Message: "a"})
+	set.Add(forge.Result{Status: forge.StatusError, Message: "b", Code: 1})
+	data, err = json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"status":"success","message":"a","code":0},{"status":"error","message":"b","code":1}]`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var decoded forge.ResultSet
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Len() != 2 || !reflect.DeepEqual(decoded.Results[1], set.Results[1]) {
+		t.Errorf("round trip = %+v", decoded)
+	}
+}
+
+func TestTableFromResults(t *testing.T) {
+	var set forge.ResultSet
+	set.Add(forge.Result{Status: forge.StatusSuccess, Message: "built"})
+	set.Add(forge.Result{Status: forge.StatusError, Message: "tests failed", Code: 1})
+	want := "" +
+		"Status  │ Message      │ Code\n" +
+		"────────┼──────────────┼─────\n" +
+		"success │ built        │    0\n" +
+		"error   │ tests failed │    1"
+	if got := table.FromResults(&set).Render(); got != want {
+		t.Errorf("Render() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"go_project/src/forge"
+)
+
+// flakyRun returns a run that fails for its first failures calls, counting
+// each call in calls
+func flakyRun(failures int, calls *int) func(forge.Options) forge.Result {
+	return func(forge.Options) forge.Result {
+		*calls++
+		if *calls <= failures {
+			return forge.Result{Status: forge.StatusError, Message: "connection refused", Code: forge.ExitError}
+		}
+		return forge.Result{Status: forge.StatusSuccess, Message: "connected", Code: forge.ExitOK}
+	}
+}
+
+func TestRunWithRetrySucceedsAfterFailures(t *testing.T) {
+	var calls int
+	var sleeps []time.Duration
+	got := forge.RunWithRetry(forge.RetryOptions{
+		MaxAttempts: 5,
+		BaseDelay:   10 * time.Millisecond,
+		MaxDelay:    time.Second,
+		Run:         flakyRun(2, &calls),
+		Sleep:       func(d time.Duration) { sleeps = append(sleeps, d) },
+	})
+	if got.Status != forge.StatusSuccess || got.Message != "connected" {
+		t.Errorf("RunWithRetry() = %+v, want success", got)
+	}
+	if calls != 3 {
+		t.Errorf("made %d attempts, want 3", calls)
+	}
+	if want := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond}; !slices.Equal(sleeps, want) {
+		t.Errorf("slept %v, want %v", sleeps, want)
+	}
+}
+
+func TestRunWithRetryExhausted(t *testing.T) {
+	var calls int
+	var sleeps []time.Duration
+	got := forge.RunWithRetry(forge.RetryOptions{
+		MaxAttempts: 4,
+		BaseDelay:   time.Second,
+		MaxDelay:    3 * time.Second,
+		Run:         flakyRun(10, &calls),
+		Sleep:       func(d time.Duration) { sleeps = append(sleeps, d) },
+	})
+	if got.Status != forge.StatusError || got.Code != forge.ExitError {
+		t.Errorf("RunWithRetry() = %+v, want the last error", got)
+	}
+	if want := "connection refused (after 4 attempts)"; got.Message != want {
+		t.Errorf("message = %q, want %q", got.Message, want)
+	}
+	if calls != 4 {
+		t.Errorf("made %d attempts, want 4", calls)
+	}
+	if want := []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}; !slices.Equal(sleeps, want) {
+		t.Errorf("slept %v, want %v", sleeps, want)
+	}
+}
+
+func TestRunWithRetryDefaultRun(t *testing.T) {
+	got := forge.RunWithRetry(forge.RetryOptions{MaxAttempts: 3, Sleep: func(time.Duration) {
+		t.Error("unexpected sleep after a successful run")
+	}})
+	if got.Status != forge.StatusSuccess {
+		t.Errorf("RunWithRetry() = %+v, want success", got)
+	}
+}
+
+func TestRunWithRetryDelayNeverOverflows(t *testing.T) {
+	var calls int
+	var longest time.Duration
+	forge.RunWithRetry(forge.RetryOptions{
+		MaxAttempts: 200,
+		Run:         flakyRun(1000, &calls),
+		Sleep: func(d time.Duration) {
+			if d <= 0 || d > forge.DefaultMaxDelay {
+				t.Fatalf("attempt %d slept %v, want (0, %v]", calls, d, forge.DefaultMaxDelay)
+			}
+			longest = max(longest, d)
+		},
+	})
+	if longest != forge.DefaultMaxDelay {
+		t.Errorf("longest sleep = %v, want %v", longest, forge.DefaultMaxDelay)
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"go_project/src/forge"
+)
+
+func TestSchemaDescribesResult(t *testing.T) {
+	data, err := forge.Schema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schema struct {
+		Schema     string `json:"$schema"`
+		Type       string `json:"type"`
+		Properties map[string]struct {
+			Type string   `json:"type"`
+			Enum []string `json:"enum"`
+		} `json:"properties"`
+		Required []string `json:"required"`
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("schema is not valid JSON: %v\n%s", err, data)
+	}
+	if schema.Schema != "http://json-schema.org/draft-07/schema#" || schema.Type != "object" {
+		t.Errorf("unexpected schema header: %s", data)
+	}
+
+	status := schema.Properties["status"]
+	want := []string{forge.StatusSuccess, forge.StatusError, forge.StatusWarning}
+	if status.Type != "string" || !slices.Equal(status.Enum, want) {
+		t.Errorf("status = %+v, want a string enum of %v", status, want)
+	}
+	if p := schema.Properties["message"]; p.Type != "string" {
+		t.Errorf("message type = %q, want string", p.Type)
+	}
+	if p := schema.Properties["code"]; p.Type != "integer" {
+		t.Errorf("code type = %q, want integer", p.Type)
+	}
+	if p := schema.Properties["fields"]; p.Type != "object" {
+		t.Errorf("fields type = %q, want object", p.Type)
+	}
+	if want := []string{"status", "message", "code"}; !slices.Equal(schema.Required, want) {
+		t.Errorf("required = %v, want %v", schema.Required, want)
+	}
+}
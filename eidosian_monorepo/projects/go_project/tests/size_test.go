@@ -0,0 +1,38 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"go_project/src/term"
+)
+
+func TestTerminalSizeFallsBackToEnv(t *testing.T) {
+	if term.IsTerminal(os.Stdout) {
+		t.Skip("stdout is a terminal")
+	}
+	t.Setenv("COLUMNS", "132")
+	t.Setenv("LINES", "43")
+	cols, rows, err := term.TerminalSize()
+	if err == nil {
+		t.Error("expected an error querying a non-terminal")
+	}
+	if cols != 132 || rows != 43 {
+		t.Errorf("TerminalSize() = %dx%d, want 132x43", cols, rows)
+	}
+	if w := term.Width(); w != 132 {
+		t.Errorf("Width() = %d, want 132", w)
+	}
+}
+
+func TestTerminalSizeDefaults(t *testing.T) {
+	if term.IsTerminal(os.Stdout) {
+		t.Skip("stdout is a terminal")
+	}
+	t.Setenv("COLUMNS", "")
+	t.Setenv("LINES", "not-a-number")
+	cols, rows, _ := term.TerminalSize()
+	if cols != term.DefaultWidth || rows != term.DefaultHeight {
+		t.Errorf("TerminalSize() = %dx%d, want %dx%d", cols, rows, term.DefaultWidth, term.DefaultHeight)
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"go_project/src/progress"
+)
+
+// frameWriter forwards every write to a channel so tests can observe frames
+// in the order the spinner draws them
+type frameWriter chan string
+
+func (w frameWriter) Write(p []byte) (int, error) {
+	w <- string(p)
+	return len(p), nil
+}
+
+func TestSpinnerAdvancesOnTicks(t *testing.T) {
+	ticks := make(chan time.Time)
+	stopped := false
+	out := make(frameWriter, 16)
+	s := progress.NewSpinner(progress.SpinnerOptions{
+		Frames: []string{"a", "b", "c"},
+		Output: out,
+		Ticker: func(time.Duration) (<-chan time.Time, func()) {
+			return ticks, func() { stopped = true }
+		},
+	})
+	s.Interactive = true
+
+	s.Start()
+	want := []string{"\ra", "\rb", "\rc", "\ra"}
+	for i, frame := range want {
+		if i > 0 {
+			ticks <- time.Time{}
+		}
+		if got := <-out; got != frame {
+			t.Fatalf("frame %d = %q, want %q", i, got, frame)
+		}
+	}
+
+	s.Stop()
+	if got := <-out; got != "\r\x1b[K" {
+		t.Errorf("expected Stop to clear the line, got %q", got)
+	}
+	if !stopped {
+		t.Error("expected Stop to stop the ticker")
+	}
+	s.Stop()
+}
+
+func TestSpinnerSilentWhenNotInteractive(t *testing.T) {
+	var buf bytes.Buffer
+	s := progress.NewSpinner(progress.SpinnerOptions{Output: &buf, Interval: time.Millisecond})
+	s.Start()
+	time.Sleep(5 * time.Millisecond)
+	s.Stop()
+	s.Stop()
+	if buf.Len() != 0 {
+		t.Errorf("expected no output for a non-terminal, got %q", buf.String())
+	}
+}
+
+func TestSpinnerIntervalThrottled(t *testing.T) {
+	var got time.Duration
+	s := progress.NewSpinner(progress.SpinnerOptions{
+		Interval: time.Microsecond,
+		Output:   make(frameWriter, 4),
+		Ticker: func(d time.Duration) (<-chan time.Time, func()) {
+			got = d
+			return make(chan time.Time), func() {}
+		},
+	})
+	s.Interactive = true
+	s.Start()
+	s.Stop()
+	if got != progress.DefaultRedrawInterval {
+		t.Errorf("ticker interval = %v, want %v", got, progress.DefaultRedrawInterval)
+	}
+}
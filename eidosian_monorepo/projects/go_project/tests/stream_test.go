@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"go_project/src/forge"
+)
+
+func TestStreamWriterConcurrentLines(t *testing.T) {
+	var buf bytes.Buffer
+	sw := forge.NewStreamWriter(&buf)
+
+	const n = 50
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r := forge.Result{Status: forge.StatusSuccess, Message: fmt.Sprintf("step %d", i)}
+			if err := sw.Write(r); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != n {
+		t.Fatalf("got %d lines, want %d", len(lines), n)
+	}
+	seen := make(map[string]bool)
+	for _, line := range lines {
+		var r forge.Result
+		if err := json.Unmarshal([]byte(line), &r); err != nil {
+			t.Fatalf("line %q: %v", line, err)
+		}
+		if err := r.Validate(); err != nil {
+			t.Errorf("line %q: %v", line, err)
+		}
+		seen[r.Message] = true
+	}
+	if len(seen) != n {
+		t.Errorf("got %d distinct results, want %d", len(seen), n)
+	}
+}
+
+func TestStreamWriterFlushesBufferedWriter(t *testing.T) {
+	var buf bytes.Buffer
+	sw := forge.NewStreamWriter(bufio.NewWriter(&buf))
+	if err := sw.Write(forge.Result{Status: forge.StatusError, Message: "boom", Code: forge.ExitError}); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"status":"error","message":"boom","code":1}` + "\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"testing"
+
+	"go_project/src/text"
+)
+
+func TestStripANSI(t *testing.T) {
+	cases := []struct {
+		name, in, want string
+	}{
+		{"plain", "hello", "hello"},
+		{"sgr", "\x1b[1;31merror\x1b[0m: disk full", "error: disk full"},
+		{"truecolor", "\x1b[38;2;255;0;0mré\x1b[0m", "ré"},
+		{"hyperlink st", "\x1b]8;;https://example.com\x1b\\docs\x1b]8;;\x1b\\", "docs"},
+		{"hyperlink bel", "\x1b]8;;https://example.com\adocs\x1b]8;;\a", "docs"},
+		{"cursor", "\r\x1b[2K\x1b[?25h\x1b[3Adone", "\rdone"},
+		{"adjacent utf-8", "日\x1b[32m本\x1b[0m語✓", "日本語✓"},
+	}
+	for _, c := range cases {
+		if got := text.StripANSI(c.in); got != c.want {
+			t.Errorf("%s: StripANSI(%q) = %q, want %q", c.name, c.in, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"testing"
+
+	"go_project/src/color"
+)
+
+func TestStyleCombinedParams(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+	t.Setenv("COLORTERM", "truecolor")
+
+	s := color.Style{
+		Attrs: color.Bold | color.Underline | color.Italic | color.Dim | color.Strikethrough,
+		Fg:    color.Red,
+		Bg:    color.RGB(1, 2, 3),
+	}
+	if got, want := s.Apply("hi"), "\x1b[1;2;3;4;9;31;48;2;1;2;3mhi\x1b[0m"; got != want {
+		t.Errorf("Apply() = %q, want %q", got, want)
+	}
+	if got, want := (color.Style{Attrs: color.Blink}).Apply("hi"), "\x1b[5mhi\x1b[0m"; got != want {
+		t.Errorf("Apply(blink) = %q, want %q", got, want)
+	}
+}
+
+func TestStyleOmitsUnsupportedAttributes(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+	t.Setenv("COLORTERM", "")
+	t.Setenv("TERM", "linux")
+
+	s := color.Style{Attrs: color.Bold | color.Italic | color.Strikethrough, Fg: color.Green}
+	if got, want := s.Apply("hi"), "\x1b[1;32mhi\x1b[0m"; got != want {
+		t.Errorf("Apply() = %q, want %q", got, want)
+	}
+	if got := (color.Style{Attrs: color.Italic}).Apply("hi"); got != "hi" {
+		t.Errorf("Apply(italic) = %q, want plain text", got)
+	}
+}
+
+func TestStyleKeepsItalicOn16ColorEmulators(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+	t.Setenv("COLORTERM", "")
+	t.Setenv("TERM", "xterm")
+
+	s := color.Style{Attrs: color.Italic | color.Strikethrough, Fg: color.Green}
+	if got, want := s.Apply("hi"), "\x1b[3;9;32mhi\x1b[0m"; got != want {
+		t.Errorf("Apply() = %q, want %q", got, want)
+	}
+	t.Setenv("TERM", "vt100")
+	if got, want := s.Apply("hi"), "\x1b[32mhi\x1b[0m"; got != want {
+		t.Errorf("Apply() on vt100 = %q, want %q", got, want)
+	}
+}
+
+func TestStyleZeroAndDisabled(t *testing.T) {
+	color.SetMode(color.Always)
+	if got := (color.Style{}).Apply("hi"); got != "hi" {
+		t.Errorf("zero Style.Apply() = %q, want plain text", got)
+	}
+	color.SetMode(color.Never)
+	defer color.SetMode(color.Auto)
+	if got := (color.Style{Attrs: color.Bold, Fg: color.Red}).Apply("hi"); got != "hi" {
+		t.Errorf("Apply() with color disabled = %q, want plain text", got)
+	}
+}
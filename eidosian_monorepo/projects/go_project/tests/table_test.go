@@ -0,0 +1,159 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"unicode"
+
+	"go_project/src/table"
+	"go_project/src/term"
+	"go_project/src/text"
+)
+
+// columns reports the display width of s for the scripts used in these tests
+func columns(s string) int {
+	n := 0
+	for _, r := range s {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+			n += 2
+		} else {
+			n++
+		}
+	}
+	return n
+}
+
+// gapColumns returns the display columns at which sep occurs in line
+func gapColumns(line, sep string) []int {
+	var cols []int
+	offset := 0
+	for {
+		i := strings.Index(line, sep)
+		if i < 0 {
+			return cols
+		}
+		cols = append(cols, offset+columns(line[:i]))
+		offset += columns(line[:i+len(sep)])
+		line = line[i+len(sep):]
+	}
+}
+
+func TestTableAlignsCJK(t *testing.T) {
+	tbl := table.New()
+	tbl.SetHeader("Name", "City", "Count")
+	tbl.AddRow("alice", "東京", "3")
+	tbl.AddRow("박지민", "Seoul", "12")
+	tbl.AddRow("bob", "北京市", "100")
+	tbl.SetAlignment(2, table.AlignRight)
+
+	lines := strings.Split(tbl.Render(), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("expected 5 lines, got %d:\n%s", len(lines), tbl.Render())
+	}
+	want := gapColumns(lines[1], "─┼─")
+	if len(want) != 2 {
+		t.Fatalf("expected two column separators, got %q", lines[1])
+	}
+	for _, line := range append([]string{lines[0]}, lines[2:]...) {
+		if got := gapColumns(line, " │ "); !slices.Equal(got, want) {
+			t.Errorf("row %q has gaps at %v, separator at %v", line, got, want)
+		}
+		if columns(line) != columns(lines[1]) {
+			t.Errorf("row %q is %d columns, separator is %d", line, columns(line), columns(lines[1]))
+		}
+	}
+	if !strings.HasSuffix(lines[2], "    3") {
+		t.Errorf("expected right-aligned count, got %q", lines[2])
+	}
+}
+
+func TestTableCenterAlignment(t *testing.T) {
+	tbl := table.New()
+	tbl.SetHeader("Status")
+	tbl.AddRow("ok")
+	tbl.SetAlignment(0, table.AlignCenter)
+	want := "Status\n──────\n  ok  "
+	if got := tbl.Render(); got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestTableRaggedRows(t *testing.T) {
+	var tbl table.Table
+	tbl.AddRow("a", "b")
+	tbl.AddRow("c")
+	if got, want := tbl.Render(), "a │ b\nc │  "; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestTableTruncatesToMaxWidth(t *testing.T) {
+	tbl := table.New()
+	tbl.SetHeader("ID", "Description", "Owner")
+	tbl.AddRow("1", "東京都の新しいプロジェクトの説明文", "alice")
+	tbl.AddRow("2", "short", "ボブ・スミスさん")
+	tbl.SetTruncate(0, false)
+	tbl.SetMaxWidth(30)
+
+	lines := strings.Split(tbl.Render(), "\n")
+	want := gapColumns(lines[1], "─┼─")
+	for _, line := range lines {
+		if w := columns(line); w > 30 {
+			t.Errorf("line %q is %d columns wide, want <= 30", line, w)
+		}
+	}
+	for _, line := range append([]string{lines[0]}, lines[2:]...) {
+		if got := gapColumns(line, " │ "); !slices.Equal(got, want) {
+			t.Errorf("row %q has gaps at %v, separator at %v", line, got, want)
+		}
+	}
+	if !strings.Contains(lines[2], "…") || !strings.Contains(lines[3], "…") {
+		t.Errorf("expected wide cells to be truncated:\n%s", tbl.Render())
+	}
+	if !strings.HasPrefix(lines[0], "ID │ ") {
+		t.Errorf("expected the ID column to keep its width, got %q", lines[0])
+	}
+}
+
+func TestTableTruncateBudget(t *testing.T) {
+	for budget := 1; budget <= 8; budget++ {
+		tbl := table.New()
+		tbl.AddRow("漢字かな混じり文", "x")
+		tbl.SetTruncate(1, false)
+		tbl.SetMaxWidth(budget + 4)
+		cell := strings.Split(tbl.Render(), " │ ")[0]
+		if w := columns(cell); w != budget {
+			t.Errorf("budget %d: cell %q is %d columns wide", budget, cell, w)
+		}
+		if !strings.HasSuffix(strings.TrimRight(cell, " "), "…") {
+			t.Errorf("budget %d: expected an ellipsis in %q", budget, cell)
+		}
+	}
+}
+
+func TestTableMaxWidthVariationSelectorAndTab(t *testing.T) {
+	for _, cell := range []string{"❤️❤️❤️❤️❤️", "a\tbcdefghij"} {
+		tbl := table.New()
+		tbl.AddRow(cell, "x")
+		tbl.SetTruncate(1, false)
+		tbl.SetMaxWidth(8)
+		for _, line := range strings.Split(tbl.Render(), "\n") {
+			if w := text.DisplayWidth(line); w > 8 {
+				t.Errorf("line %q is %d columns wide, want <= 8", line, w)
+			}
+		}
+	}
+}
+
+func TestTableTruncatedHyperlinkIsClosed(t *testing.T) {
+	t.Setenv(term.EnvForceHyperlinks, "1")
+	tbl := table.New()
+	tbl.AddRow(term.Hyperlink("documentation", "https://x.example"), "b")
+	tbl.SetTruncate(1, false)
+	tbl.SetMaxWidth(12)
+	want := "\x1b]8;;https://x.example\x1b\\documen…\x1b]8;;\x1b\\ │ b"
+	if got := tbl.Render(); got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
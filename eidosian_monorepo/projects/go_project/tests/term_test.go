@@ -0,0 +1,41 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go_project/src/term"
+)
+
+func TestIsTerminalRejectsNonTerminals(t *testing.T) {
+	if term.IsTerminal(nil) {
+		t.Error("expected nil file not to be a terminal")
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+	if term.IsTerminal(w) {
+		t.Error("expected pipe not to be a terminal")
+	}
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if term.IsTerminal(f) {
+		t.Error("expected regular file not to be a terminal")
+	}
+}
+
+func TestIsTerminalWriterWithBuffer(t *testing.T) {
+	if term.IsTerminalWriter(&bytes.Buffer{}) {
+		t.Error("expected buffer not to be a terminal")
+	}
+}
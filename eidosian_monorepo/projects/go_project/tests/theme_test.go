@@ -0,0 +1,122 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go_project/src/color"
+	"go_project/src/forge"
+	"go_project/src/term"
+	"go_project/src/theme"
+)
+
+func writeTheme(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "theme.json")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestDefaultTheme(t *testing.T) {
+	th := theme.DefaultTheme()
+	cases := map[string]color.Color{
+		"success": color.Green,
+		"error":   color.Red,
+		"warning": color.Yellow,
+		"other":   color.Blue,
+	}
+	for status, want := range cases {
+		if got := th.StatusColor(status); got != want {
+			t.Errorf("StatusColor(%q) = %v, want %v", status, got, want)
+		}
+	}
+	if th.BorderColor != color.BrightBlack {
+		t.Errorf("BorderColor = %v, want bright-black", th.BorderColor)
+	}
+	var nilTheme *theme.Theme
+	if *nilTheme.OrDefault() != *th {
+		t.Error("expected nil theme to fall back to the default")
+	}
+}
+
+func TestLoadTheme(t *testing.T) {
+	th, err := theme.LoadTheme(writeTheme(t, `{"success": "Bright-Green", "border": "cyan"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if th.SuccessColor != color.BrightGreen || th.BorderColor != color.Cyan {
+		t.Errorf("unexpected theme %+v", th)
+	}
+	if th.ErrorColor != color.Red {
+		t.Errorf("expected unset roles to keep defaults, got error=%v", th.ErrorColor)
+	}
+}
+
+func TestLoadThemeRejectsMalformedFiles(t *testing.T) {
+	cases := map[string]string{
+		"invalid json":  `{"success": `,
+		"unknown color": `{"success": "chartreuse"}`,
+		"unknown role":  `{"highlight": "red"}`,
+		"wrong type":    `{"error": 1}`,
+	}
+	for name, contents := range cases {
+		if _, err := theme.LoadTheme(writeTheme(t, contents)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	if _, err := theme.LoadTheme(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestRenderBoxUsesThemeBorder(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+
+	th := theme.DefaultTheme()
+	th.BorderColor = color.Magenta
+	got := forge.RenderBoxWith(forge.Result{Status: "success"}, forge.BoxOptions{Theme: th})
+	if !strings.HasPrefix(got, "\x1b[35m┌") {
+		t.Errorf("expected magenta border, got %q", got)
+	}
+	if !strings.Contains(got, "\x1b[32msuccess\x1b[0m") {
+		t.Errorf("expected green status, got %q", got)
+	}
+}
+
+func TestStatusIconASCII(t *testing.T) {
+	color.SetMode(color.Never)
+	defer color.SetMode(color.Auto)
+
+	icons := theme.Icons{ASCII: true}
+	for status, want := range map[string]string{
+		"success": "[OK]",
+		"error":   "[ERR]",
+		"warning": "[WARN]",
+		"other":   "[INFO]",
+	} {
+		if got := icons.StatusIcon(status); got != want {
+			t.Errorf("StatusIcon(%q) = %q, want %q", status, got, want)
+		}
+	}
+}
+
+func TestStatusIconSymbols(t *testing.T) {
+	color.SetMode(color.Always)
+	defer color.SetMode(color.Auto)
+
+	icons := theme.IconsFor(theme.DefaultTheme(), term.Capabilities{Color: true, Emoji: true})
+	if got, want := icons.StatusIcon("success"), "\x1b[32m✓\x1b[0m"; got != want {
+		t.Errorf("StatusIcon(success) = %q, want %q", got, want)
+	}
+	if got, want := icons.StatusIcon("other"), "\x1b[34mℹ\x1b[0m"; got != want {
+		t.Errorf("StatusIcon(other) = %q, want %q", got, want)
+	}
+	if got := theme.IconsFor(nil, term.Capabilities{}).StatusIcon("success"); got != "\x1b[32m[OK]\x1b[0m" {
+		t.Errorf("expected ASCII markers without emoji support, got %q", got)
+	}
+}
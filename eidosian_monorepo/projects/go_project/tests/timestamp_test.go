@@ -0,0 +1,50 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"go_project/src/text"
+)
+
+func TestTimestampWriterOnePrefixPerLine(t *testing.T) {
+	clock := time.Date(2024, 3, 9, 14, 5, 0, 0, time.UTC)
+	var buf bytes.Buffer
+	w := text.NewTimestampWriter(&buf, func() time.Time { return clock })
+
+	io.WriteString(w, "first line\nsec")
+	io.WriteString(w, "ond ")
+	io.WriteString(w, "line\nthird")
+	if want := "2024-03-09T14:05:00Z first line\n2024-03-09T14:05:00Z second line\n"; buf.String() != want {
+		t.Errorf("before Flush got %q, want %q", buf.String(), want)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(buf.String(), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3: %q", len(lines), buf.String())
+	}
+	for _, line := range lines {
+		if n := strings.Count(line, "2024-03-09T14:05:00Z"); n != 1 || !strings.HasPrefix(line, "2024-03-09T14:05:00Z ") {
+			t.Errorf("line %q carries %d timestamps, want one prefix", line, n)
+		}
+	}
+}
+
+func TestTimestampWriterStampsAtCompletion(t *testing.T) {
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.FixedZone("CET", 3600))
+	var buf bytes.Buffer
+	w := text.NewTimestampWriter(&buf, func() time.Time { return clock })
+
+	io.WriteString(w, "slow ")
+	clock = clock.Add(time.Minute)
+	io.WriteString(w, "line\n")
+	if want := "2024-01-01T00:01:00+01:00 slow line\n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+
+	"go_project/src/text"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{"empty", "", 0},
+		{"ascii", "hello", 5},
+		{"combining acute", "café", 4},
+		{"stacked combining", "à̖b", 2},
+		{"cjk", "日本語", 6},
+		{"hangul", "한국어", 6},
+		{"fullwidth", "ＡＢ", 4},
+		{"emoji", "🚀", 2},
+		{"emoji with vs16", "❤️", 2},
+		{"vs16 after wide", "🚀️", 2},
+		{"text presentation", "❤", 1},
+		{"sgr", "\x1b[1;31merror\x1b[0m", 5},
+		{"hyperlink", "\x1b]8;;https://example.com\x1b\\docs\x1b]8;;\x1b\\", 4},
+		{"escapes around cjk", "\x1b[32m日\x1b[0m本", 4},
+		{"tab at start", "\tx", 9},
+		{"tab mid column", "abc\tx", 9},
+		{"tab on stop", "abcdefgh\tx", 17},
+		{"zero width joiner", "a‍b", 2},
+		{"zwj family", "👨‍👩‍👧", 2},
+		{"zwj with vs16", "❤️‍🔥", 2},
+		{"skin tone", "👍🏽", 2},
+		{"flag", "🇯🇵", 2},
+		{"emoji presentation", "✅❌", 4},
+		{"check mark", "✓ ok", 4},
+	}
+	for _, c := range cases {
+		if got := text.DisplayWidth(c.in); got != c.want {
+			t.Errorf("%s: DisplayWidth(%q) = %d, want %d", c.name, c.in, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"go_project/src/text"
+)
+
+var sgrPattern = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// visibleWidth counts the runes of s that are not part of an SGR sequence
+func visibleWidth(s string) int {
+	return utf8.RuneCountInString(sgrPattern.ReplaceAllString(s, ""))
+}
+
+func TestWrapTextPlain(t *testing.T) {
+	got := text.WrapText("the quick brown fox jumps", 10)
+	want := []string{"the quick", "brown fox", "jumps"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("WrapText() = %q, want %q", got, want)
+	}
+}
+
+func TestWrapTextColoredWords(t *testing.T) {
+	red, green, reset := "\x1b[31m", "\x1b[32m", "\x1b[0m"
+	s := red + "error" + reset + " while " + green + "connecting" + reset + " to the database server"
+	got := text.WrapText(s, 12)
+	if len(got) < 3 {
+		t.Fatalf("expected several lines, got %q", got)
+	}
+	exceeds := false
+	for _, line := range got {
+		if w := visibleWidth(line); w > 12 {
+			t.Errorf("line %q is %d columns wide, want <= 12", line, w)
+		}
+		if len(line) > 12 {
+			exceeds = true
+		}
+	}
+	if !exceeds {
+		t.Error("expected escapes to make some line longer in bytes than its width")
+	}
+	if got[0] != red+"error"+reset+" while" {
+		t.Errorf("first line = %q", got[0])
+	}
+	if got[1] != green+"connecting"+reset {
+		t.Errorf("second line = %q", got[1])
+	}
+}
+
+func TestWrapTextCarriesColorAcrossBreaks(t *testing.T) {
+	got := text.WrapText("\x1b[1;34mone two three\x1b[0m four", 8)
+	want := []string{
+		"\x1b[1;34mone two\x1b[0m",
+		"\x1b[1;34mthree\x1b[0m",
+		"four",
+	}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("WrapText() = %q, want %q", got, want)
+	}
+}
+
+func TestWrapTextCarriesHyperlinkAcrossBreaks(t *testing.T) {
+	open, end := "\x1b]8;;https://example.com\x1b\\", "\x1b]8;;\x1b\\"
+	got := text.WrapText(open+"read the docs"+end+" now", 8)
+	want := []string{
+		open + "read the" + end,
+		open + "docs" + end + " now",
+	}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("WrapText() = %q, want %q", got, want)
+	}
+}
+
+func TestWrapTextHardBreaksLongTokens(t *testing.T) {
+	got := text.WrapText("\x1b[33mabcdefghij\x1b[0m", 4)
+	want := []string{"\x1b[33mabcd\x1b[0m", "\x1b[33mefgh\x1b[0m", "\x1b[33mij\x1b[0m"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("WrapText() = %q, want %q", got, want)
+	}
+	for _, line := range got {
+		if strings.Count(line, "\x1b[") != 2 {
+			t.Errorf("escape split in %q", line)
+		}
+	}
+}
+
+func TestWrapTextNewlinesAndEmpty(t *testing.T) {
+	got := text.WrapText("a\n\nb", 5)
+	if strings.Join(got, "|") != "a||b" {
+		t.Errorf("WrapText() = %q", got)
+	}
+	if got := text.WrapText("", 5); len(got) != 1 || got[0] != "" {
+		t.Errorf("WrapText(empty) = %q", got)
+	}
+}
+
+func TestWrapTextVariationSelector(t *testing.T) {
+	got := text.WrapText("❤️❤️❤️❤️ ok", 4)
+	want := []string{"❤️❤️", "❤️❤️", "ok"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("WrapText() = %q, want %q", got, want)
+	}
+	for _, line := range got {
+		if w := text.DisplayWidth(line); w > 4 {
+			t.Errorf("line %q is %d columns wide, want <= 4", line, w)
+		}
+	}
+}
+
+func TestWrapTextKeepsZWJSequences(t *testing.T) {
+	got := text.WrapText("👨‍👩‍👧👍🏽👨‍👩‍👧", 4)
+	want := []string{"👨‍👩‍👧👍🏽", "👨‍👩‍👧"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("WrapText() = %q, want %q", got, want)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	cases := []struct {
+		in   string
+		w    int
+		want string
+	}{
+		{"hello", 10, "hello"},
+		{"hello", 5, "hello"},
+		{"hello world", 6, "hello…"},
+		{"漢字漢字", 5, "漢字…"},
+		{"漢字漢字", 4, "漢…"},
+		{"abc", 1, "…"},
+		{"abc", 0, ""},
+		{"\x1b[31mredtext\x1b[0m", 4, "\x1b[31mred…\x1b[0m"},
+		{"\x1b]8;;https://x.example\x07linktext\x1b]8;;\x07", 5, "\x1b]8;;https://x.example\x07link…\x1b]8;;\x1b\\"},
+		{"❤️❤️❤️❤️", 5, "❤️❤️…"},
+		{"❤️❤️❤️❤️", 4, "❤️…"},
+		{"ab\tcdefghij", 10, "ab\tc…"},
+		{"ab\tcdefghij", 8, "ab…"},
+		{"👨‍👩‍👧👨‍👩‍👧👨‍👩‍👧x", 5, "👨‍👩‍👧👨‍👩‍👧…"},
+		{"👨‍👩‍👧👨‍👩‍👧👨‍👩‍👧x", 3, "👨‍👩‍👧…"},
+		{"🇯🇵🇫🇷🇩🇪", 3, "🇯🇵…"},
+	}
+	for _, c := range cases {
+		if got := text.Truncate(c.in, c.w); got != c.want {
+			t.Errorf("Truncate(%q, %d) = %q, want %q", c.in, c.w, got, c.want)
+		}
+	}
+}